@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -63,9 +64,27 @@ func main() {
 	}
 
 	registry := session.NewRegistry(cfg.MaxConcurrentSession)
-	workers := worker.NewManager(cfg.PythonWorkerPath, logger)
-	stateDir := filepath.Join(cfg.DatabaseDirectory, "sessions")
-	store, err := session.NewStore(stateDir)
+	// Sessions run in local worker processes unless remote agents are configured
+	var workers worker.Controller
+	var local *worker.Manager
+	if len(cfg.WorkerHosts) > 0 {
+		tlsConfig, err := worker.LoadTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile, false)
+		if err != nil {
+			logger.Fatalf("failed to load worker TLS config: %v", err)
+		}
+		remote := worker.NewRemoteController(cfg.WorkerHosts, tlsConfig, logger)
+		workers = remote
+		logger.Printf("Using remote worker hosts: %s", strings.Join(cfg.WorkerHosts, ", "))
+	} else {
+		local = server.NewWorkerManager(cfg, logger)
+		workers = local
+	}
+	var store *session.Store
+	if cfg.SessionStore == server.SessionStoreBolt {
+		store, err = session.OpenBoltStore(filepath.Join(cfg.DatabaseDirectory, "sessions.db"))
+	} else {
+		store, err = session.NewStore(filepath.Join(cfg.DatabaseDirectory, "sessions"))
+	}
 	if err != nil {
 		logger.Fatalf("failed to initialize session store: %v", err)
 	}
@@ -75,10 +94,24 @@ func main() {
 	workers.CleanupOrphanProcesses()
 
 	srv := server.New(registry, workers, logger, sessionTimeout, cfg.Debug, store)
+	srv.SetEviction(cfg.SessionEviction, cfg.PinnedBinaries)
+	srv.SetStaleBinaryPolicy(cfg.StaleBinary)
+	srv.SetDatabaseDirectory(cfg.DatabaseDirectory)
+	srv.SetStorageQuotas(cfg.DatabaseQuotaMB, cfg.SessionQuotaMB)
+	srv.SetToolTimeouts(cfg.ToolTimeoutSec, cfg.ToolTimeouts)
+	srv.SetMaxHeavyOperations(cfg.MaxHeavyOperations)
+	if local != nil {
+		local.OnRecovery(srv.HandleWorkerRecovery)
+	}
 
 	srv.RestoreSessions()
+	if local != nil {
+		srv.PruneDatabases()
+		local.SetPoolSize(cfg.WorkerPoolSize)
+	}
 
 	go srv.Watchdog()
+	go srv.AutoSave(time.Duration(cfg.AutoSaveIntervalMin) * time.Minute)
 
 	mcpServer := mcp.NewServer(&mcp.Implementation{
 		Name:    "ida-headless",
@@ -115,11 +148,20 @@ func main() {
 		}
 
 		// Stop all workers and log any errors
+		if local != nil {
+			local.Close()
+		}
 		for _, sess := range registry.List() {
+			if sess.Dormant() {
+				continue
+			}
 			if err := workers.Stop(sess.ID); err != nil {
 				logger.Printf("Failed to stop worker %s: %v", sess.ID, err)
 			}
 		}
+		if err := store.Close(); err != nil {
+			logger.Printf("Failed to close session store: %v", err)
+		}
 
 		logger.Println("Shutdown complete")
 		os.Exit(0)
@@ -135,6 +177,61 @@ func validateConfig(cfg *server.Config) error {
 		return fmt.Errorf("max_concurrent_sessions must be non-negative, got %d (use 0 for unlimited)", cfg.MaxConcurrentSession)
 	}
 
+	if cfg.WorkerMemoryLimitMB < 0 || cfg.WorkerCPUPercent < 0 || cfg.MinFreeMemoryMB < 0 || cfg.AdmissionWaitSec < 0 {
+		return fmt.Errorf("resource limits must be non-negative (use 0 for unlimited)")
+	}
+
+	if cfg.WorkerPoolSize < 0 {
+		return fmt.Errorf("worker_pool_size must be non-negative, got %d (use 0 to disable)", cfg.WorkerPoolSize)
+	}
+
+	if cfg.DatabaseQuotaMB < 0 || cfg.SessionQuotaMB < 0 {
+		return fmt.Errorf("database_quota_mb and session_quota_mb must be non-negative (use 0 for unlimited)")
+	}
+
+	if cfg.ToolTimeoutSec < 0 {
+		return fmt.Errorf("tool_timeout_seconds must be non-negative, got %d (use 0 for no deadline)", cfg.ToolTimeoutSec)
+	}
+	for tool, sec := range cfg.ToolTimeouts {
+		if sec < 0 {
+			return fmt.Errorf("tool_timeouts.%s must be non-negative, got %d (use 0 for no deadline)", tool, sec)
+		}
+	}
+
+	if cfg.MaxHeavyOperations < 0 {
+		return fmt.Errorf("max_heavy_operations must be non-negative, got %d (use 0 for half the CPUs)", cfg.MaxHeavyOperations)
+	}
+
+	if cfg.WorkerLogLines < 0 {
+		return fmt.Errorf("worker_log_lines must be non-negative, got %d (use 0 for the default)", cfg.WorkerLogLines)
+	}
+
+	switch cfg.SessionEviction {
+	case "", server.EvictionReject, server.EvictionLRU:
+	default:
+		return fmt.Errorf("session_eviction must be %q or %q, got %q", server.EvictionReject, server.EvictionLRU, cfg.SessionEviction)
+	}
+
+	switch cfg.SessionStore {
+	case "", server.SessionStoreFiles, server.SessionStoreBolt:
+	default:
+		return fmt.Errorf("session_store must be %q or %q, got %q", server.SessionStoreFiles, server.SessionStoreBolt, cfg.SessionStore)
+	}
+
+	switch cfg.StaleBinary {
+	case "", server.StaleBinaryWarn, server.StaleBinaryRefuse:
+	default:
+		return fmt.Errorf("stale_binary must be %q or %q, got %q", server.StaleBinaryWarn, server.StaleBinaryRefuse, cfg.StaleBinary)
+	}
+
+	if len(cfg.WorkerHosts) > 0 {
+		// Workers run on the agents; only the TLS material is needed here
+		if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" || cfg.TLSCAFile == "" {
+			return fmt.Errorf("worker_hosts requires tls_cert_file, tls_key_file and tls_ca_file")
+		}
+		return nil
+	}
+
 	if cfg.PythonWorkerPath == "" {
 		return fmt.Errorf("python_worker_path is required")
 	}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zboralski/ida-headless-mcp/internal/server"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+var (
+	configPath   = flag.String("config", "config.json", "Path to server config (worker settings are shared)")
+	listenAddr   = flag.String("listen", ":17310", "Address to accept the MCP server on")
+	certFile     = flag.String("cert", "", "Agent TLS certificate")
+	keyFile      = flag.String("key", "", "Agent TLS private key")
+	caFile       = flag.String("ca", "", "CA that signs MCP server client certificates")
+	pythonWorker = flag.String("worker", "", "Python worker script (overrides config)")
+	capacity     = flag.Int("capacity", -1, "Max sessions on this host (default: max_concurrent_sessions)")
+)
+
+func main() {
+	flag.Parse()
+
+	logger := log.New(os.Stdout, "[Agent] ", log.LstdFlags)
+	cfg, err := server.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatalf("failed to load config: %v", err)
+	}
+	server.ApplyEnvOverrides(&cfg)
+	if *pythonWorker != "" {
+		cfg.PythonWorkerPath = *pythonWorker
+	}
+	if *capacity < 0 {
+		*capacity = cfg.MaxConcurrentSession
+	}
+	if *certFile == "" || *keyFile == "" || *caFile == "" {
+		logger.Fatalf("-cert, -key and -ca are required")
+	}
+	absPath, err := filepath.Abs(cfg.PythonWorkerPath)
+	if err != nil {
+		logger.Fatalf("invalid python_worker_path %q: %v", cfg.PythonWorkerPath, err)
+	}
+	cfg.PythonWorkerPath = absPath
+
+	tlsConfig, err := worker.LoadTLSConfig(*certFile, *keyFile, *caFile, true)
+	if err != nil {
+		logger.Fatalf("failed to load TLS config: %v", err)
+	}
+
+	workers := server.NewWorkerManager(cfg, logger)
+	workers.CleanupOrphanSockets()
+	workers.CleanupOrphanProcesses()
+	workers.SetPoolSize(cfg.WorkerPoolSize)
+
+	agent := worker.NewAgent(workers, *capacity, logger)
+	httpServer := &http.Server{
+		Addr:      *listenAddr,
+		Handler:   agent.Handler(),
+		TLSConfig: tlsConfig,
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	notifyShutdown(sigChan)
+	go func() {
+		<-sigChan
+		logger.Println("Shutting down gracefully...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Printf("HTTP server shutdown error: %v", err)
+		}
+		agent.Shutdown()
+		logger.Println("Shutdown complete")
+		os.Exit(0)
+	}()
+
+	logger.Printf("Listening on %s (capacity %s)", *listenAddr, capacityLabel(*capacity))
+	if err := httpServer.ListenAndServeTLS("", ""); err != http.ErrServerClosed {
+		logger.Fatal(err)
+	}
+}
+
+func capacityLabel(n int) string {
+	if n == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
@@ -25,7 +25,8 @@ const (
 type OpenBinaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BinaryPath    string                 `protobuf:"bytes,1,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
-	AutoAnalyze   bool                   `protobuf:"varint,2,opt,name=auto_analyze,json=autoAnalyze,proto3" json:"auto_analyze,omitempty"` // Run auto-analysis
+	AutoAnalyze   bool                   `protobuf:"varint,2,opt,name=auto_analyze,json=autoAnalyze,proto3" json:"auto_analyze,omitempty"`   // Run auto-analysis
+	DatabasePath  string                 `protobuf:"bytes,3,opt,name=database_path,json=databasePath,proto3" json:"database_path,omitempty"` // Where to keep the IDA database; empty keeps it next to the binary
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,6 +75,13 @@ func (x *OpenBinaryRequest) GetAutoAnalyze() bool {
 	return false
 }
 
+func (x *OpenBinaryRequest) GetDatabasePath() string {
+	if x != nil {
+		return x.DatabasePath
+	}
+	return ""
+}
+
 // OpenBinaryResponse returns session metadata
 type OpenBinaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -81,6 +89,8 @@ type OpenBinaryResponse struct {
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	HasDecompiler bool                   `protobuf:"varint,3,opt,name=has_decompiler,json=hasDecompiler,proto3" json:"has_decompiler,omitempty"`
 	BinaryPath    string                 `protobuf:"bytes,4,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+	Processor     string                 `protobuf:"bytes,5,opt,name=processor,proto3" json:"processor,omitempty"` // IDA processor module name, e.g. "metapc", "ARM"
+	Bitness       int32                  `protobuf:"varint,6,opt,name=bitness,proto3" json:"bitness,omitempty"`    // 16, 32 or 64
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -143,6 +153,20 @@ func (x *OpenBinaryResponse) GetBinaryPath() string {
 	return ""
 }
 
+func (x *OpenBinaryResponse) GetProcessor() string {
+	if x != nil {
+		return x.Processor
+	}
+	return ""
+}
+
+func (x *OpenBinaryResponse) GetBitness() int32 {
+	if x != nil {
+		return x.Bitness
+	}
+	return 0
+}
+
 // CloseSessionRequest triggers database close
 type CloseSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -567,6 +591,113 @@ func (x *GetSessionInfoResponse) GetAutoState() string {
 	return ""
 }
 
+// CancelRequest names the call to abort by the Ida-Request-Id header it was
+// sent with
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	WaitMs        uint32                 `protobuf:"varint,2,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"` // How long to wait for a running call to stop
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_ida_worker_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ida_worker_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetWaitMs() uint32 {
+	if x != nil {
+		return x.WaitMs
+	}
+	return 0
+}
+
+// CancelResponse reports whether the call was found and has stopped
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`     // The call was running or queued
+	Stopped       bool                   `protobuf:"varint,2,opt,name=stopped,proto3" json:"stopped,omitempty"` // It stopped, or will not start, within wait_ms
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_ida_worker_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ida_worker_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *CancelResponse) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
 // GetBytesRequest specifies address and size
 type GetBytesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -578,7 +709,7 @@ type GetBytesRequest struct {
 
 func (x *GetBytesRequest) Reset() {
 	*x = GetBytesRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[10]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -590,7 +721,7 @@ func (x *GetBytesRequest) String() string {
 func (*GetBytesRequest) ProtoMessage() {}
 
 func (x *GetBytesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[10]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +734,7 @@ func (x *GetBytesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBytesRequest.ProtoReflect.Descriptor instead.
 func (*GetBytesRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{10}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetBytesRequest) GetAddress() uint64 {
@@ -631,7 +762,7 @@ type GetBytesResponse struct {
 
 func (x *GetBytesResponse) Reset() {
 	*x = GetBytesResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[11]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +774,7 @@ func (x *GetBytesResponse) String() string {
 func (*GetBytesResponse) ProtoMessage() {}
 
 func (x *GetBytesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[11]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +787,7 @@ func (x *GetBytesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBytesResponse.ProtoReflect.Descriptor instead.
 func (*GetBytesResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{11}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBytesResponse) GetData() []byte {
@@ -683,7 +814,7 @@ type GetDisasmRequest struct {
 
 func (x *GetDisasmRequest) Reset() {
 	*x = GetDisasmRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[12]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -695,7 +826,7 @@ func (x *GetDisasmRequest) String() string {
 func (*GetDisasmRequest) ProtoMessage() {}
 
 func (x *GetDisasmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[12]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +839,7 @@ func (x *GetDisasmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDisasmRequest.ProtoReflect.Descriptor instead.
 func (*GetDisasmRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{12}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetDisasmRequest) GetAddress() uint64 {
@@ -729,7 +860,7 @@ type GetDisasmResponse struct {
 
 func (x *GetDisasmResponse) Reset() {
 	*x = GetDisasmResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[13]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +872,7 @@ func (x *GetDisasmResponse) String() string {
 func (*GetDisasmResponse) ProtoMessage() {}
 
 func (x *GetDisasmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[13]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +885,7 @@ func (x *GetDisasmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDisasmResponse.ProtoReflect.Descriptor instead.
 func (*GetDisasmResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{13}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetDisasmResponse) GetDisasm() string {
@@ -780,7 +911,7 @@ type GetFunctionDisasmRequest struct {
 
 func (x *GetFunctionDisasmRequest) Reset() {
 	*x = GetFunctionDisasmRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[14]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +923,7 @@ func (x *GetFunctionDisasmRequest) String() string {
 func (*GetFunctionDisasmRequest) ProtoMessage() {}
 
 func (x *GetFunctionDisasmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[14]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +936,7 @@ func (x *GetFunctionDisasmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionDisasmRequest.ProtoReflect.Descriptor instead.
 func (*GetFunctionDisasmRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{14}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFunctionDisasmRequest) GetAddress() uint64 {
@@ -825,7 +956,7 @@ type GetFunctionDisasmResponse struct {
 
 func (x *GetFunctionDisasmResponse) Reset() {
 	*x = GetFunctionDisasmResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[15]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +968,7 @@ func (x *GetFunctionDisasmResponse) String() string {
 func (*GetFunctionDisasmResponse) ProtoMessage() {}
 
 func (x *GetFunctionDisasmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[15]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +981,7 @@ func (x *GetFunctionDisasmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionDisasmResponse.ProtoReflect.Descriptor instead.
 func (*GetFunctionDisasmResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{15}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetFunctionDisasmResponse) GetDisassembly() string {
@@ -877,7 +1008,7 @@ type GetDecompiledRequest struct {
 
 func (x *GetDecompiledRequest) Reset() {
 	*x = GetDecompiledRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[16]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -889,7 +1020,7 @@ func (x *GetDecompiledRequest) String() string {
 func (*GetDecompiledRequest) ProtoMessage() {}
 
 func (x *GetDecompiledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[16]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1033,7 @@ func (x *GetDecompiledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDecompiledRequest.ProtoReflect.Descriptor instead.
 func (*GetDecompiledRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{16}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetDecompiledRequest) GetAddress() uint64 {
@@ -923,7 +1054,7 @@ type GetDecompiledResponse struct {
 
 func (x *GetDecompiledResponse) Reset() {
 	*x = GetDecompiledResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[17]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -935,7 +1066,7 @@ func (x *GetDecompiledResponse) String() string {
 func (*GetDecompiledResponse) ProtoMessage() {}
 
 func (x *GetDecompiledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[17]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1079,7 @@ func (x *GetDecompiledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDecompiledResponse.ProtoReflect.Descriptor instead.
 func (*GetDecompiledResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{17}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetDecompiledResponse) GetCode() string {
@@ -975,7 +1106,7 @@ type GetFunctionNameRequest struct {
 
 func (x *GetFunctionNameRequest) Reset() {
 	*x = GetFunctionNameRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[18]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1118,7 @@ func (x *GetFunctionNameRequest) String() string {
 func (*GetFunctionNameRequest) ProtoMessage() {}
 
 func (x *GetFunctionNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[18]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1131,7 @@ func (x *GetFunctionNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionNameRequest.ProtoReflect.Descriptor instead.
 func (*GetFunctionNameRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{18}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetFunctionNameRequest) GetAddress() uint64 {
@@ -1021,7 +1152,7 @@ type GetFunctionNameResponse struct {
 
 func (x *GetFunctionNameResponse) Reset() {
 	*x = GetFunctionNameResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[19]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1164,7 @@ func (x *GetFunctionNameResponse) String() string {
 func (*GetFunctionNameResponse) ProtoMessage() {}
 
 func (x *GetFunctionNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[19]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1177,7 @@ func (x *GetFunctionNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionNameResponse.ProtoReflect.Descriptor instead.
 func (*GetFunctionNameResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{19}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetFunctionNameResponse) GetName() string {
@@ -1072,7 +1203,7 @@ type GetSegmentsRequest struct {
 
 func (x *GetSegmentsRequest) Reset() {
 	*x = GetSegmentsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[20]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1084,7 +1215,7 @@ func (x *GetSegmentsRequest) String() string {
 func (*GetSegmentsRequest) ProtoMessage() {}
 
 func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[20]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1228,7 @@ func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSegmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetSegmentsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{20}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{22}
 }
 
 // Segment represents memory segment
@@ -1115,7 +1246,7 @@ type Segment struct {
 
 func (x *Segment) Reset() {
 	*x = Segment{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[21]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1127,7 +1258,7 @@ func (x *Segment) String() string {
 func (*Segment) ProtoMessage() {}
 
 func (x *Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[21]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1271,7 @@ func (x *Segment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Segment.ProtoReflect.Descriptor instead.
 func (*Segment) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{21}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Segment) GetStart() uint64 {
@@ -1196,7 +1327,7 @@ type GetSegmentsResponse struct {
 
 func (x *GetSegmentsResponse) Reset() {
 	*x = GetSegmentsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[22]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1339,7 @@ func (x *GetSegmentsResponse) String() string {
 func (*GetSegmentsResponse) ProtoMessage() {}
 
 func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[22]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1352,7 @@ func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSegmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetSegmentsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{22}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetSegmentsResponse) GetSegments() []*Segment {
@@ -1247,7 +1378,7 @@ type GetFunctionsRequest struct {
 
 func (x *GetFunctionsRequest) Reset() {
 	*x = GetFunctionsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[23]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1259,7 +1390,7 @@ func (x *GetFunctionsRequest) String() string {
 func (*GetFunctionsRequest) ProtoMessage() {}
 
 func (x *GetFunctionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[23]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,7 +1403,7 @@ func (x *GetFunctionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionsRequest.ProtoReflect.Descriptor instead.
 func (*GetFunctionsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{23}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{25}
 }
 
 // Function represents a function
@@ -1286,7 +1417,7 @@ type Function struct {
 
 func (x *Function) Reset() {
 	*x = Function{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[24]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1429,7 @@ func (x *Function) String() string {
 func (*Function) ProtoMessage() {}
 
 func (x *Function) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[24]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1442,7 @@ func (x *Function) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Function.ProtoReflect.Descriptor instead.
 func (*Function) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{24}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Function) GetAddress() uint64 {
@@ -1339,7 +1470,7 @@ type GetFunctionsResponse struct {
 
 func (x *GetFunctionsResponse) Reset() {
 	*x = GetFunctionsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[25]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1351,7 +1482,7 @@ func (x *GetFunctionsResponse) String() string {
 func (*GetFunctionsResponse) ProtoMessage() {}
 
 func (x *GetFunctionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[25]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,7 +1495,7 @@ func (x *GetFunctionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionsResponse.ProtoReflect.Descriptor instead.
 func (*GetFunctionsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{25}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetFunctionsResponse) GetFunctions() []*Function {
@@ -1391,7 +1522,7 @@ type GetXRefsToRequest struct {
 
 func (x *GetXRefsToRequest) Reset() {
 	*x = GetXRefsToRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[26]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +1534,7 @@ func (x *GetXRefsToRequest) String() string {
 func (*GetXRefsToRequest) ProtoMessage() {}
 
 func (x *GetXRefsToRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[26]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +1547,7 @@ func (x *GetXRefsToRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXRefsToRequest.ProtoReflect.Descriptor instead.
 func (*GetXRefsToRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{26}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetXRefsToRequest) GetAddress() uint64 {
@@ -1438,7 +1569,7 @@ type XRef struct {
 
 func (x *XRef) Reset() {
 	*x = XRef{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[27]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1581,7 @@ func (x *XRef) String() string {
 func (*XRef) ProtoMessage() {}
 
 func (x *XRef) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[27]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1594,7 @@ func (x *XRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use XRef.ProtoReflect.Descriptor instead.
 func (*XRef) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{27}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *XRef) GetFrom() uint64 {
@@ -1498,7 +1629,7 @@ type GetXRefsToResponse struct {
 
 func (x *GetXRefsToResponse) Reset() {
 	*x = GetXRefsToResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[28]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1510,7 +1641,7 @@ func (x *GetXRefsToResponse) String() string {
 func (*GetXRefsToResponse) ProtoMessage() {}
 
 func (x *GetXRefsToResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[28]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1654,7 @@ func (x *GetXRefsToResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXRefsToResponse.ProtoReflect.Descriptor instead.
 func (*GetXRefsToResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{28}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetXRefsToResponse) GetXrefs() []*XRef {
@@ -1550,7 +1681,7 @@ type GetXRefsFromRequest struct {
 
 func (x *GetXRefsFromRequest) Reset() {
 	*x = GetXRefsFromRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[29]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1693,7 @@ func (x *GetXRefsFromRequest) String() string {
 func (*GetXRefsFromRequest) ProtoMessage() {}
 
 func (x *GetXRefsFromRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[29]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1706,7 @@ func (x *GetXRefsFromRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXRefsFromRequest.ProtoReflect.Descriptor instead.
 func (*GetXRefsFromRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{29}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetXRefsFromRequest) GetAddress() uint64 {
@@ -1596,7 +1727,7 @@ type GetXRefsFromResponse struct {
 
 func (x *GetXRefsFromResponse) Reset() {
 	*x = GetXRefsFromResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[30]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1608,7 +1739,7 @@ func (x *GetXRefsFromResponse) String() string {
 func (*GetXRefsFromResponse) ProtoMessage() {}
 
 func (x *GetXRefsFromResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[30]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,7 +1752,7 @@ func (x *GetXRefsFromResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXRefsFromResponse.ProtoReflect.Descriptor instead.
 func (*GetXRefsFromResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{30}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetXRefsFromResponse) GetXrefs() []*XRef {
@@ -1648,7 +1779,7 @@ type GetDataRefsRequest struct {
 
 func (x *GetDataRefsRequest) Reset() {
 	*x = GetDataRefsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[31]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1660,7 +1791,7 @@ func (x *GetDataRefsRequest) String() string {
 func (*GetDataRefsRequest) ProtoMessage() {}
 
 func (x *GetDataRefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[31]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +1804,7 @@ func (x *GetDataRefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataRefsRequest.ProtoReflect.Descriptor instead.
 func (*GetDataRefsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{31}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetDataRefsRequest) GetAddress() uint64 {
@@ -1694,7 +1825,7 @@ type DataRef struct {
 
 func (x *DataRef) Reset() {
 	*x = DataRef{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[32]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1706,7 +1837,7 @@ func (x *DataRef) String() string {
 func (*DataRef) ProtoMessage() {}
 
 func (x *DataRef) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[32]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +1850,7 @@ func (x *DataRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataRef.ProtoReflect.Descriptor instead.
 func (*DataRef) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{32}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DataRef) GetFrom() uint64 {
@@ -1747,7 +1878,7 @@ type GetDataRefsResponse struct {
 
 func (x *GetDataRefsResponse) Reset() {
 	*x = GetDataRefsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[33]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1759,7 +1890,7 @@ func (x *GetDataRefsResponse) String() string {
 func (*GetDataRefsResponse) ProtoMessage() {}
 
 func (x *GetDataRefsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[33]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,7 +1903,7 @@ func (x *GetDataRefsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataRefsResponse.ProtoReflect.Descriptor instead.
 func (*GetDataRefsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{33}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetDataRefsResponse) GetRefs() []*DataRef {
@@ -1799,7 +1930,7 @@ type GetStringXRefsRequest struct {
 
 func (x *GetStringXRefsRequest) Reset() {
 	*x = GetStringXRefsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[34]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1811,7 +1942,7 @@ func (x *GetStringXRefsRequest) String() string {
 func (*GetStringXRefsRequest) ProtoMessage() {}
 
 func (x *GetStringXRefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[34]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +1955,7 @@ func (x *GetStringXRefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStringXRefsRequest.ProtoReflect.Descriptor instead.
 func (*GetStringXRefsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{34}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetStringXRefsRequest) GetAddress() uint64 {
@@ -1846,7 +1977,7 @@ type StringXRef struct {
 
 func (x *StringXRef) Reset() {
 	*x = StringXRef{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[35]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1858,7 +1989,7 @@ func (x *StringXRef) String() string {
 func (*StringXRef) ProtoMessage() {}
 
 func (x *StringXRef) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[35]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,7 +2002,7 @@ func (x *StringXRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringXRef.ProtoReflect.Descriptor instead.
 func (*StringXRef) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{35}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *StringXRef) GetAddress() uint64 {
@@ -1906,7 +2037,7 @@ type GetStringXRefsResponse struct {
 
 func (x *GetStringXRefsResponse) Reset() {
 	*x = GetStringXRefsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[36]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1918,7 +2049,7 @@ func (x *GetStringXRefsResponse) String() string {
 func (*GetStringXRefsResponse) ProtoMessage() {}
 
 func (x *GetStringXRefsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[36]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,7 +2062,7 @@ func (x *GetStringXRefsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStringXRefsResponse.ProtoReflect.Descriptor instead.
 func (*GetStringXRefsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{36}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetStringXRefsResponse) GetRefs() []*StringXRef {
@@ -1957,7 +2088,7 @@ type GetImportsRequest struct {
 
 func (x *GetImportsRequest) Reset() {
 	*x = GetImportsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[37]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1969,7 +2100,7 @@ func (x *GetImportsRequest) String() string {
 func (*GetImportsRequest) ProtoMessage() {}
 
 func (x *GetImportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[37]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1982,7 +2113,7 @@ func (x *GetImportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImportsRequest.ProtoReflect.Descriptor instead.
 func (*GetImportsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{37}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{39}
 }
 
 // Import represents imported function
@@ -1998,7 +2129,7 @@ type Import struct {
 
 func (x *Import) Reset() {
 	*x = Import{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[38]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2010,7 +2141,7 @@ func (x *Import) String() string {
 func (*Import) ProtoMessage() {}
 
 func (x *Import) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[38]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,7 +2154,7 @@ func (x *Import) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Import.ProtoReflect.Descriptor instead.
 func (*Import) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{38}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Import) GetModule() string {
@@ -2065,7 +2196,7 @@ type GetImportsResponse struct {
 
 func (x *GetImportsResponse) Reset() {
 	*x = GetImportsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[39]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2077,7 +2208,7 @@ func (x *GetImportsResponse) String() string {
 func (*GetImportsResponse) ProtoMessage() {}
 
 func (x *GetImportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[39]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2221,7 @@ func (x *GetImportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImportsResponse.ProtoReflect.Descriptor instead.
 func (*GetImportsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{39}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetImportsResponse) GetImports() []*Import {
@@ -2116,7 +2247,7 @@ type GetExportsRequest struct {
 
 func (x *GetExportsRequest) Reset() {
 	*x = GetExportsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[40]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2128,7 +2259,7 @@ func (x *GetExportsRequest) String() string {
 func (*GetExportsRequest) ProtoMessage() {}
 
 func (x *GetExportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[40]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2141,7 +2272,7 @@ func (x *GetExportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExportsRequest.ProtoReflect.Descriptor instead.
 func (*GetExportsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{40}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{42}
 }
 
 // Export represents exported function
@@ -2157,7 +2288,7 @@ type Export struct {
 
 func (x *Export) Reset() {
 	*x = Export{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[41]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2169,7 +2300,7 @@ func (x *Export) String() string {
 func (*Export) ProtoMessage() {}
 
 func (x *Export) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[41]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,7 +2313,7 @@ func (x *Export) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Export.ProtoReflect.Descriptor instead.
 func (*Export) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{41}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *Export) GetIndex() uint64 {
@@ -2224,7 +2355,7 @@ type GetExportsResponse struct {
 
 func (x *GetExportsResponse) Reset() {
 	*x = GetExportsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[42]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2236,7 +2367,7 @@ func (x *GetExportsResponse) String() string {
 func (*GetExportsResponse) ProtoMessage() {}
 
 func (x *GetExportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[42]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2249,7 +2380,7 @@ func (x *GetExportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExportsResponse.ProtoReflect.Descriptor instead.
 func (*GetExportsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{42}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetExportsResponse) GetExports() []*Export {
@@ -2275,7 +2406,7 @@ type GetEntryPointRequest struct {
 
 func (x *GetEntryPointRequest) Reset() {
 	*x = GetEntryPointRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[43]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2287,7 +2418,7 @@ func (x *GetEntryPointRequest) String() string {
 func (*GetEntryPointRequest) ProtoMessage() {}
 
 func (x *GetEntryPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[43]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2300,7 +2431,7 @@ func (x *GetEntryPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEntryPointRequest.ProtoReflect.Descriptor instead.
 func (*GetEntryPointRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{43}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{45}
 }
 
 // GetEntryPointResponse returns entry address
@@ -2314,7 +2445,7 @@ type GetEntryPointResponse struct {
 
 func (x *GetEntryPointResponse) Reset() {
 	*x = GetEntryPointResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[44]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2326,7 +2457,7 @@ func (x *GetEntryPointResponse) String() string {
 func (*GetEntryPointResponse) ProtoMessage() {}
 
 func (x *GetEntryPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[44]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,7 +2470,7 @@ func (x *GetEntryPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEntryPointResponse.ProtoReflect.Descriptor instead.
 func (*GetEntryPointResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{44}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetEntryPointResponse) GetAddress() uint64 {
@@ -2367,7 +2498,7 @@ type GetStringsRequest struct {
 
 func (x *GetStringsRequest) Reset() {
 	*x = GetStringsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[45]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2379,7 +2510,7 @@ func (x *GetStringsRequest) String() string {
 func (*GetStringsRequest) ProtoMessage() {}
 
 func (x *GetStringsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[45]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,7 +2523,7 @@ func (x *GetStringsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStringsRequest.ProtoReflect.Descriptor instead.
 func (*GetStringsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{45}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetStringsRequest) GetOffset() int32 {
@@ -2420,7 +2551,7 @@ type StringItem struct {
 
 func (x *StringItem) Reset() {
 	*x = StringItem{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[46]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +2563,7 @@ func (x *StringItem) String() string {
 func (*StringItem) ProtoMessage() {}
 
 func (x *StringItem) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[46]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +2576,7 @@ func (x *StringItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringItem.ProtoReflect.Descriptor instead.
 func (*StringItem) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{46}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *StringItem) GetAddress() uint64 {
@@ -2476,7 +2607,7 @@ type GetStringsResponse struct {
 
 func (x *GetStringsResponse) Reset() {
 	*x = GetStringsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[47]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2488,7 +2619,7 @@ func (x *GetStringsResponse) String() string {
 func (*GetStringsResponse) ProtoMessage() {}
 
 func (x *GetStringsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[47]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,7 +2632,7 @@ func (x *GetStringsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStringsResponse.ProtoReflect.Descriptor instead.
 func (*GetStringsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{47}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetStringsResponse) GetStrings() []*StringItem {
@@ -2549,7 +2680,7 @@ type MakeFunctionRequest struct {
 
 func (x *MakeFunctionRequest) Reset() {
 	*x = MakeFunctionRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[48]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2561,7 +2692,7 @@ func (x *MakeFunctionRequest) String() string {
 func (*MakeFunctionRequest) ProtoMessage() {}
 
 func (x *MakeFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[48]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,7 +2705,7 @@ func (x *MakeFunctionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeFunctionRequest.ProtoReflect.Descriptor instead.
 func (*MakeFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{48}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *MakeFunctionRequest) GetAddress() uint64 {
@@ -2595,7 +2726,7 @@ type MakeFunctionResponse struct {
 
 func (x *MakeFunctionResponse) Reset() {
 	*x = MakeFunctionResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[49]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2607,7 +2738,7 @@ func (x *MakeFunctionResponse) String() string {
 func (*MakeFunctionResponse) ProtoMessage() {}
 
 func (x *MakeFunctionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[49]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2620,7 +2751,7 @@ func (x *MakeFunctionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeFunctionResponse.ProtoReflect.Descriptor instead.
 func (*MakeFunctionResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{49}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *MakeFunctionResponse) GetSuccess() bool {
@@ -2649,7 +2780,7 @@ type ImportIl2CppRequest struct {
 
 func (x *ImportIl2CppRequest) Reset() {
 	*x = ImportIl2CppRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[50]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2661,7 +2792,7 @@ func (x *ImportIl2CppRequest) String() string {
 func (*ImportIl2CppRequest) ProtoMessage() {}
 
 func (x *ImportIl2CppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[50]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +2805,7 @@ func (x *ImportIl2CppRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportIl2CppRequest.ProtoReflect.Descriptor instead.
 func (*ImportIl2CppRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{50}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ImportIl2CppRequest) GetScriptPath() string {
@@ -2716,7 +2847,7 @@ type ImportIl2CppResponse struct {
 
 func (x *ImportIl2CppResponse) Reset() {
 	*x = ImportIl2CppResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[51]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2728,7 +2859,7 @@ func (x *ImportIl2CppResponse) String() string {
 func (*ImportIl2CppResponse) ProtoMessage() {}
 
 func (x *ImportIl2CppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[51]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,7 +2872,7 @@ func (x *ImportIl2CppResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportIl2CppResponse.ProtoReflect.Descriptor instead.
 func (*ImportIl2CppResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{51}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ImportIl2CppResponse) GetSuccess() bool {
@@ -2817,7 +2948,7 @@ type ImportFlutterRequest struct {
 
 func (x *ImportFlutterRequest) Reset() {
 	*x = ImportFlutterRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[52]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2829,7 +2960,7 @@ func (x *ImportFlutterRequest) String() string {
 func (*ImportFlutterRequest) ProtoMessage() {}
 
 func (x *ImportFlutterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[52]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2842,7 +2973,7 @@ func (x *ImportFlutterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportFlutterRequest.ProtoReflect.Descriptor instead.
 func (*ImportFlutterRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{52}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ImportFlutterRequest) GetBlutterOutputPath() string {
@@ -2866,7 +2997,7 @@ type ImportFlutterResponse struct {
 
 func (x *ImportFlutterResponse) Reset() {
 	*x = ImportFlutterResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[53]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2878,7 +3009,7 @@ func (x *ImportFlutterResponse) String() string {
 func (*ImportFlutterResponse) ProtoMessage() {}
 
 func (x *ImportFlutterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[53]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,7 +3022,7 @@ func (x *ImportFlutterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportFlutterResponse.ProtoReflect.Descriptor instead.
 func (*ImportFlutterResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{53}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ImportFlutterResponse) GetSuccess() bool {
@@ -2939,7 +3070,7 @@ type GetDwordAtRequest struct {
 
 func (x *GetDwordAtRequest) Reset() {
 	*x = GetDwordAtRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[54]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2951,7 +3082,7 @@ func (x *GetDwordAtRequest) String() string {
 func (*GetDwordAtRequest) ProtoMessage() {}
 
 func (x *GetDwordAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[54]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,7 +3095,7 @@ func (x *GetDwordAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDwordAtRequest.ProtoReflect.Descriptor instead.
 func (*GetDwordAtRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{54}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetDwordAtRequest) GetAddress() uint64 {
@@ -2985,7 +3116,7 @@ type GetDwordAtResponse struct {
 
 func (x *GetDwordAtResponse) Reset() {
 	*x = GetDwordAtResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[55]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2997,7 +3128,7 @@ func (x *GetDwordAtResponse) String() string {
 func (*GetDwordAtResponse) ProtoMessage() {}
 
 func (x *GetDwordAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[55]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,7 +3141,7 @@ func (x *GetDwordAtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDwordAtResponse.ProtoReflect.Descriptor instead.
 func (*GetDwordAtResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{55}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetDwordAtResponse) GetValue() uint32 {
@@ -3037,7 +3168,7 @@ type GetQwordAtRequest struct {
 
 func (x *GetQwordAtRequest) Reset() {
 	*x = GetQwordAtRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[56]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3049,7 +3180,7 @@ func (x *GetQwordAtRequest) String() string {
 func (*GetQwordAtRequest) ProtoMessage() {}
 
 func (x *GetQwordAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[56]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,7 +3193,7 @@ func (x *GetQwordAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQwordAtRequest.ProtoReflect.Descriptor instead.
 func (*GetQwordAtRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{56}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetQwordAtRequest) GetAddress() uint64 {
@@ -3083,7 +3214,7 @@ type GetQwordAtResponse struct {
 
 func (x *GetQwordAtResponse) Reset() {
 	*x = GetQwordAtResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[57]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3095,7 +3226,7 @@ func (x *GetQwordAtResponse) String() string {
 func (*GetQwordAtResponse) ProtoMessage() {}
 
 func (x *GetQwordAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[57]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3108,7 +3239,7 @@ func (x *GetQwordAtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQwordAtResponse.ProtoReflect.Descriptor instead.
 func (*GetQwordAtResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{57}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetQwordAtResponse) GetValue() uint64 {
@@ -3135,7 +3266,7 @@ type GetInstructionLengthRequest struct {
 
 func (x *GetInstructionLengthRequest) Reset() {
 	*x = GetInstructionLengthRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[58]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3147,7 +3278,7 @@ func (x *GetInstructionLengthRequest) String() string {
 func (*GetInstructionLengthRequest) ProtoMessage() {}
 
 func (x *GetInstructionLengthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[58]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3160,7 +3291,7 @@ func (x *GetInstructionLengthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstructionLengthRequest.ProtoReflect.Descriptor instead.
 func (*GetInstructionLengthRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{58}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetInstructionLengthRequest) GetAddress() uint64 {
@@ -3181,7 +3312,7 @@ type GetInstructionLengthResponse struct {
 
 func (x *GetInstructionLengthResponse) Reset() {
 	*x = GetInstructionLengthResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[59]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3193,7 +3324,7 @@ func (x *GetInstructionLengthResponse) String() string {
 func (*GetInstructionLengthResponse) ProtoMessage() {}
 
 func (x *GetInstructionLengthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[59]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,7 +3337,7 @@ func (x *GetInstructionLengthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstructionLengthResponse.ProtoReflect.Descriptor instead.
 func (*GetInstructionLengthResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{59}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetInstructionLengthResponse) GetLength() uint32 {
@@ -3232,7 +3363,7 @@ type PingRequest struct {
 
 func (x *PingRequest) Reset() {
 	*x = PingRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[60]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3244,7 +3375,7 @@ func (x *PingRequest) String() string {
 func (*PingRequest) ProtoMessage() {}
 
 func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[60]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3257,7 +3388,7 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{60}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{62}
 }
 
 // PingResponse confirms alive
@@ -3270,7 +3401,7 @@ type PingResponse struct {
 
 func (x *PingResponse) Reset() {
 	*x = PingResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[61]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3282,7 +3413,7 @@ func (x *PingResponse) String() string {
 func (*PingResponse) ProtoMessage() {}
 
 func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[61]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3295,7 +3426,7 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{61}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *PingResponse) GetAlive() bool {
@@ -3315,7 +3446,7 @@ type StatusStreamRequest struct {
 
 func (x *StatusStreamRequest) Reset() {
 	*x = StatusStreamRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[62]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3327,7 +3458,7 @@ func (x *StatusStreamRequest) String() string {
 func (*StatusStreamRequest) ProtoMessage() {}
 
 func (x *StatusStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[62]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3340,7 +3471,7 @@ func (x *StatusStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusStreamRequest.ProtoReflect.Descriptor instead.
 func (*StatusStreamRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{62}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *StatusStreamRequest) GetIntervalSeconds() uint32 {
@@ -3364,7 +3495,7 @@ type WorkerStatus struct {
 
 func (x *WorkerStatus) Reset() {
 	*x = WorkerStatus{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[63]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3376,7 +3507,7 @@ func (x *WorkerStatus) String() string {
 func (*WorkerStatus) ProtoMessage() {}
 
 func (x *WorkerStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[63]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3389,7 +3520,7 @@ func (x *WorkerStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkerStatus.ProtoReflect.Descriptor instead.
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{63}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *WorkerStatus) GetTimestamp() int64 {
@@ -3439,7 +3570,7 @@ type SetCommentRequest struct {
 
 func (x *SetCommentRequest) Reset() {
 	*x = SetCommentRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[64]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3451,7 +3582,7 @@ func (x *SetCommentRequest) String() string {
 func (*SetCommentRequest) ProtoMessage() {}
 
 func (x *SetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[64]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,7 +3595,7 @@ func (x *SetCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCommentRequest.ProtoReflect.Descriptor instead.
 func (*SetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{64}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SetCommentRequest) GetAddress() uint64 {
@@ -3499,7 +3630,7 @@ type SetCommentResponse struct {
 
 func (x *SetCommentResponse) Reset() {
 	*x = SetCommentResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[65]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3511,7 +3642,7 @@ func (x *SetCommentResponse) String() string {
 func (*SetCommentResponse) ProtoMessage() {}
 
 func (x *SetCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[65]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3524,7 +3655,7 @@ func (x *SetCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCommentResponse.ProtoReflect.Descriptor instead.
 func (*SetCommentResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{65}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *SetCommentResponse) GetSuccess() bool {
@@ -3552,7 +3683,7 @@ type GetCommentRequest struct {
 
 func (x *GetCommentRequest) Reset() {
 	*x = GetCommentRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[66]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3564,7 +3695,7 @@ func (x *GetCommentRequest) String() string {
 func (*GetCommentRequest) ProtoMessage() {}
 
 func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[66]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +3708,7 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{66}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetCommentRequest) GetAddress() uint64 {
@@ -3605,7 +3736,7 @@ type GetCommentResponse struct {
 
 func (x *GetCommentResponse) Reset() {
 	*x = GetCommentResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[67]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3617,7 +3748,7 @@ func (x *GetCommentResponse) String() string {
 func (*GetCommentResponse) ProtoMessage() {}
 
 func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[67]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +3761,7 @@ func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{67}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetCommentResponse) GetComment() string {
@@ -3658,7 +3789,7 @@ type SetFuncCommentRequest struct {
 
 func (x *SetFuncCommentRequest) Reset() {
 	*x = SetFuncCommentRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[68]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3670,7 +3801,7 @@ func (x *SetFuncCommentRequest) String() string {
 func (*SetFuncCommentRequest) ProtoMessage() {}
 
 func (x *SetFuncCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[68]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3683,7 +3814,7 @@ func (x *SetFuncCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFuncCommentRequest.ProtoReflect.Descriptor instead.
 func (*SetFuncCommentRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{68}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *SetFuncCommentRequest) GetAddress() uint64 {
@@ -3711,7 +3842,7 @@ type SetFuncCommentResponse struct {
 
 func (x *SetFuncCommentResponse) Reset() {
 	*x = SetFuncCommentResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[69]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3723,7 +3854,7 @@ func (x *SetFuncCommentResponse) String() string {
 func (*SetFuncCommentResponse) ProtoMessage() {}
 
 func (x *SetFuncCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[69]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +3867,7 @@ func (x *SetFuncCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFuncCommentResponse.ProtoReflect.Descriptor instead.
 func (*SetFuncCommentResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{69}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SetFuncCommentResponse) GetSuccess() bool {
@@ -3764,7 +3895,7 @@ type SetLvarTypeRequest struct {
 
 func (x *SetLvarTypeRequest) Reset() {
 	*x = SetLvarTypeRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[70]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3776,7 +3907,7 @@ func (x *SetLvarTypeRequest) String() string {
 func (*SetLvarTypeRequest) ProtoMessage() {}
 
 func (x *SetLvarTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[70]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,7 +3920,7 @@ func (x *SetLvarTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLvarTypeRequest.ProtoReflect.Descriptor instead.
 func (*SetLvarTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{70}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *SetLvarTypeRequest) GetFunctionAddress() uint64 {
@@ -3823,7 +3954,7 @@ type SetLvarTypeResponse struct {
 
 func (x *SetLvarTypeResponse) Reset() {
 	*x = SetLvarTypeResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[71]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3835,7 +3966,7 @@ func (x *SetLvarTypeResponse) String() string {
 func (*SetLvarTypeResponse) ProtoMessage() {}
 
 func (x *SetLvarTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[71]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3848,7 +3979,7 @@ func (x *SetLvarTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLvarTypeResponse.ProtoReflect.Descriptor instead.
 func (*SetLvarTypeResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{71}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *SetLvarTypeResponse) GetSuccess() bool {
@@ -3876,7 +4007,7 @@ type RenameLvarRequest struct {
 
 func (x *RenameLvarRequest) Reset() {
 	*x = RenameLvarRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[72]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3888,7 +4019,7 @@ func (x *RenameLvarRequest) String() string {
 func (*RenameLvarRequest) ProtoMessage() {}
 
 func (x *RenameLvarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[72]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3901,7 +4032,7 @@ func (x *RenameLvarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameLvarRequest.ProtoReflect.Descriptor instead.
 func (*RenameLvarRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{72}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *RenameLvarRequest) GetFunctionAddress() uint64 {
@@ -3935,7 +4066,7 @@ type RenameLvarResponse struct {
 
 func (x *RenameLvarResponse) Reset() {
 	*x = RenameLvarResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[73]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3947,7 +4078,7 @@ func (x *RenameLvarResponse) String() string {
 func (*RenameLvarResponse) ProtoMessage() {}
 
 func (x *RenameLvarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[73]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,7 +4091,7 @@ func (x *RenameLvarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameLvarResponse.ProtoReflect.Descriptor instead.
 func (*RenameLvarResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{73}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *RenameLvarResponse) GetSuccess() bool {
@@ -3988,7 +4119,7 @@ type SetDecompilerCommentRequest struct {
 
 func (x *SetDecompilerCommentRequest) Reset() {
 	*x = SetDecompilerCommentRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[74]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4000,7 +4131,7 @@ func (x *SetDecompilerCommentRequest) String() string {
 func (*SetDecompilerCommentRequest) ProtoMessage() {}
 
 func (x *SetDecompilerCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[74]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,7 +4144,7 @@ func (x *SetDecompilerCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDecompilerCommentRequest.ProtoReflect.Descriptor instead.
 func (*SetDecompilerCommentRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{74}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SetDecompilerCommentRequest) GetFunctionAddress() uint64 {
@@ -4047,7 +4178,7 @@ type SetDecompilerCommentResponse struct {
 
 func (x *SetDecompilerCommentResponse) Reset() {
 	*x = SetDecompilerCommentResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[75]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4059,7 +4190,7 @@ func (x *SetDecompilerCommentResponse) String() string {
 func (*SetDecompilerCommentResponse) ProtoMessage() {}
 
 func (x *SetDecompilerCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[75]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4072,7 +4203,7 @@ func (x *SetDecompilerCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDecompilerCommentResponse.ProtoReflect.Descriptor instead.
 func (*SetDecompilerCommentResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{75}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *SetDecompilerCommentResponse) GetSuccess() bool {
@@ -4099,7 +4230,7 @@ type GetGlobalsRequest struct {
 
 func (x *GetGlobalsRequest) Reset() {
 	*x = GetGlobalsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[76]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4111,7 +4242,7 @@ func (x *GetGlobalsRequest) String() string {
 func (*GetGlobalsRequest) ProtoMessage() {}
 
 func (x *GetGlobalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[76]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4124,7 +4255,7 @@ func (x *GetGlobalsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGlobalsRequest.ProtoReflect.Descriptor instead.
 func (*GetGlobalsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{76}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetGlobalsRequest) GetRegex() string {
@@ -4152,7 +4283,7 @@ type GlobalVariable struct {
 
 func (x *GlobalVariable) Reset() {
 	*x = GlobalVariable{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[77]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4164,7 +4295,7 @@ func (x *GlobalVariable) String() string {
 func (*GlobalVariable) ProtoMessage() {}
 
 func (x *GlobalVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[77]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,7 +4308,7 @@ func (x *GlobalVariable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobalVariable.ProtoReflect.Descriptor instead.
 func (*GlobalVariable) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{77}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GlobalVariable) GetAddress() uint64 {
@@ -4211,7 +4342,7 @@ type GetGlobalsResponse struct {
 
 func (x *GetGlobalsResponse) Reset() {
 	*x = GetGlobalsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[78]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4223,7 +4354,7 @@ func (x *GetGlobalsResponse) String() string {
 func (*GetGlobalsResponse) ProtoMessage() {}
 
 func (x *GetGlobalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[78]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4236,7 +4367,7 @@ func (x *GetGlobalsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGlobalsResponse.ProtoReflect.Descriptor instead.
 func (*GetGlobalsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{78}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetGlobalsResponse) GetGlobals() []*GlobalVariable {
@@ -4263,7 +4394,7 @@ type SetGlobalTypeRequest struct {
 
 func (x *SetGlobalTypeRequest) Reset() {
 	*x = SetGlobalTypeRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[79]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4275,7 +4406,7 @@ func (x *SetGlobalTypeRequest) String() string {
 func (*SetGlobalTypeRequest) ProtoMessage() {}
 
 func (x *SetGlobalTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[79]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,7 +4419,7 @@ func (x *SetGlobalTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGlobalTypeRequest.ProtoReflect.Descriptor instead.
 func (*SetGlobalTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{79}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *SetGlobalTypeRequest) GetAddress() uint64 {
@@ -4315,7 +4446,7 @@ type SetGlobalTypeResponse struct {
 
 func (x *SetGlobalTypeResponse) Reset() {
 	*x = SetGlobalTypeResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[80]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4327,7 +4458,7 @@ func (x *SetGlobalTypeResponse) String() string {
 func (*SetGlobalTypeResponse) ProtoMessage() {}
 
 func (x *SetGlobalTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[80]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4340,7 +4471,7 @@ func (x *SetGlobalTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGlobalTypeResponse.ProtoReflect.Descriptor instead.
 func (*SetGlobalTypeResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{80}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *SetGlobalTypeResponse) GetSuccess() bool {
@@ -4367,7 +4498,7 @@ type RenameGlobalRequest struct {
 
 func (x *RenameGlobalRequest) Reset() {
 	*x = RenameGlobalRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[81]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4379,7 +4510,7 @@ func (x *RenameGlobalRequest) String() string {
 func (*RenameGlobalRequest) ProtoMessage() {}
 
 func (x *RenameGlobalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[81]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4392,7 +4523,7 @@ func (x *RenameGlobalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameGlobalRequest.ProtoReflect.Descriptor instead.
 func (*RenameGlobalRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{81}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *RenameGlobalRequest) GetAddress() uint64 {
@@ -4419,7 +4550,7 @@ type RenameGlobalResponse struct {
 
 func (x *RenameGlobalResponse) Reset() {
 	*x = RenameGlobalResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[82]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4431,7 +4562,7 @@ func (x *RenameGlobalResponse) String() string {
 func (*RenameGlobalResponse) ProtoMessage() {}
 
 func (x *RenameGlobalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[82]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4444,7 +4575,7 @@ func (x *RenameGlobalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameGlobalResponse.ProtoReflect.Descriptor instead.
 func (*RenameGlobalResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{82}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *RenameGlobalResponse) GetSuccess() bool {
@@ -4471,7 +4602,7 @@ type DataReadStringRequest struct {
 
 func (x *DataReadStringRequest) Reset() {
 	*x = DataReadStringRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[83]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4483,7 +4614,7 @@ func (x *DataReadStringRequest) String() string {
 func (*DataReadStringRequest) ProtoMessage() {}
 
 func (x *DataReadStringRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[83]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4496,7 +4627,7 @@ func (x *DataReadStringRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataReadStringRequest.ProtoReflect.Descriptor instead.
 func (*DataReadStringRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{83}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *DataReadStringRequest) GetAddress() uint64 {
@@ -4523,7 +4654,7 @@ type DataReadStringResponse struct {
 
 func (x *DataReadStringResponse) Reset() {
 	*x = DataReadStringResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[84]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4535,7 +4666,7 @@ func (x *DataReadStringResponse) String() string {
 func (*DataReadStringResponse) ProtoMessage() {}
 
 func (x *DataReadStringResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[84]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4548,7 +4679,7 @@ func (x *DataReadStringResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataReadStringResponse.ProtoReflect.Descriptor instead.
 func (*DataReadStringResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{84}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *DataReadStringResponse) GetValue() string {
@@ -4574,7 +4705,7 @@ type DataReadByteRequest struct {
 
 func (x *DataReadByteRequest) Reset() {
 	*x = DataReadByteRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[85]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4586,7 +4717,7 @@ func (x *DataReadByteRequest) String() string {
 func (*DataReadByteRequest) ProtoMessage() {}
 
 func (x *DataReadByteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[85]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4599,7 +4730,7 @@ func (x *DataReadByteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataReadByteRequest.ProtoReflect.Descriptor instead.
 func (*DataReadByteRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{85}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *DataReadByteRequest) GetAddress() uint64 {
@@ -4619,7 +4750,7 @@ type DataReadByteResponse struct {
 
 func (x *DataReadByteResponse) Reset() {
 	*x = DataReadByteResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[86]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4631,7 +4762,7 @@ func (x *DataReadByteResponse) String() string {
 func (*DataReadByteResponse) ProtoMessage() {}
 
 func (x *DataReadByteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[86]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4644,7 +4775,7 @@ func (x *DataReadByteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataReadByteResponse.ProtoReflect.Descriptor instead.
 func (*DataReadByteResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{86}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *DataReadByteResponse) GetValue() uint32 {
@@ -4671,7 +4802,7 @@ type ListStructsRequest struct {
 
 func (x *ListStructsRequest) Reset() {
 	*x = ListStructsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[87]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4683,7 +4814,7 @@ func (x *ListStructsRequest) String() string {
 func (*ListStructsRequest) ProtoMessage() {}
 
 func (x *ListStructsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[87]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4696,7 +4827,7 @@ func (x *ListStructsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStructsRequest.ProtoReflect.Descriptor instead.
 func (*ListStructsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{87}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ListStructsRequest) GetRegex() string {
@@ -4724,7 +4855,7 @@ type StructSummary struct {
 
 func (x *StructSummary) Reset() {
 	*x = StructSummary{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[88]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4736,7 +4867,7 @@ func (x *StructSummary) String() string {
 func (*StructSummary) ProtoMessage() {}
 
 func (x *StructSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[88]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4749,7 +4880,7 @@ func (x *StructSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StructSummary.ProtoReflect.Descriptor instead.
 func (*StructSummary) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{88}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *StructSummary) GetName() string {
@@ -4783,7 +4914,7 @@ type ListStructsResponse struct {
 
 func (x *ListStructsResponse) Reset() {
 	*x = ListStructsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[89]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4795,7 +4926,7 @@ func (x *ListStructsResponse) String() string {
 func (*ListStructsResponse) ProtoMessage() {}
 
 func (x *ListStructsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[89]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4808,7 +4939,7 @@ func (x *ListStructsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStructsResponse.ProtoReflect.Descriptor instead.
 func (*ListStructsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{89}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ListStructsResponse) GetStructs() []*StructSummary {
@@ -4834,7 +4965,7 @@ type GetStructRequest struct {
 
 func (x *GetStructRequest) Reset() {
 	*x = GetStructRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[90]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4846,7 +4977,7 @@ func (x *GetStructRequest) String() string {
 func (*GetStructRequest) ProtoMessage() {}
 
 func (x *GetStructRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[90]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4859,7 +4990,7 @@ func (x *GetStructRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStructRequest.ProtoReflect.Descriptor instead.
 func (*GetStructRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{90}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetStructRequest) GetName() string {
@@ -4881,7 +5012,7 @@ type StructMember struct {
 
 func (x *StructMember) Reset() {
 	*x = StructMember{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[91]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4893,7 +5024,7 @@ func (x *StructMember) String() string {
 func (*StructMember) ProtoMessage() {}
 
 func (x *StructMember) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[91]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4906,7 +5037,7 @@ func (x *StructMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StructMember.ProtoReflect.Descriptor instead.
 func (*StructMember) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{91}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *StructMember) GetName() string {
@@ -4950,7 +5081,7 @@ type GetStructResponse struct {
 
 func (x *GetStructResponse) Reset() {
 	*x = GetStructResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[92]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4962,7 +5093,7 @@ func (x *GetStructResponse) String() string {
 func (*GetStructResponse) ProtoMessage() {}
 
 func (x *GetStructResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[92]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4975,7 +5106,7 @@ func (x *GetStructResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStructResponse.ProtoReflect.Descriptor instead.
 func (*GetStructResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{92}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *GetStructResponse) GetName() string {
@@ -5023,7 +5154,7 @@ type ListEnumsRequest struct {
 
 func (x *ListEnumsRequest) Reset() {
 	*x = ListEnumsRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[93]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5035,7 +5166,7 @@ func (x *ListEnumsRequest) String() string {
 func (*ListEnumsRequest) ProtoMessage() {}
 
 func (x *ListEnumsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[93]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5048,7 +5179,7 @@ func (x *ListEnumsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnumsRequest.ProtoReflect.Descriptor instead.
 func (*ListEnumsRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{93}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *ListEnumsRequest) GetRegex() string {
@@ -5075,7 +5206,7 @@ type EnumSummary struct {
 
 func (x *EnumSummary) Reset() {
 	*x = EnumSummary{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[94]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5087,7 +5218,7 @@ func (x *EnumSummary) String() string {
 func (*EnumSummary) ProtoMessage() {}
 
 func (x *EnumSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[94]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5100,7 +5231,7 @@ func (x *EnumSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumSummary.ProtoReflect.Descriptor instead.
 func (*EnumSummary) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{94}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *EnumSummary) GetName() string {
@@ -5127,7 +5258,7 @@ type ListEnumsResponse struct {
 
 func (x *ListEnumsResponse) Reset() {
 	*x = ListEnumsResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[95]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5139,7 +5270,7 @@ func (x *ListEnumsResponse) String() string {
 func (*ListEnumsResponse) ProtoMessage() {}
 
 func (x *ListEnumsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[95]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5152,7 +5283,7 @@ func (x *ListEnumsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnumsResponse.ProtoReflect.Descriptor instead.
 func (*ListEnumsResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{95}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ListEnumsResponse) GetEnums() []*EnumSummary {
@@ -5178,7 +5309,7 @@ type GetEnumRequest struct {
 
 func (x *GetEnumRequest) Reset() {
 	*x = GetEnumRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[96]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5190,7 +5321,7 @@ func (x *GetEnumRequest) String() string {
 func (*GetEnumRequest) ProtoMessage() {}
 
 func (x *GetEnumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[96]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5203,7 +5334,7 @@ func (x *GetEnumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnumRequest.ProtoReflect.Descriptor instead.
 func (*GetEnumRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{96}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetEnumRequest) GetName() string {
@@ -5223,7 +5354,7 @@ type EnumMember struct {
 
 func (x *EnumMember) Reset() {
 	*x = EnumMember{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[97]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5235,7 +5366,7 @@ func (x *EnumMember) String() string {
 func (*EnumMember) ProtoMessage() {}
 
 func (x *EnumMember) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[97]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5248,7 +5379,7 @@ func (x *EnumMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumMember.ProtoReflect.Descriptor instead.
 func (*EnumMember) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{97}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *EnumMember) GetName() string {
@@ -5277,7 +5408,7 @@ type GetEnumResponse struct {
 
 func (x *GetEnumResponse) Reset() {
 	*x = GetEnumResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[98]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5289,7 +5420,7 @@ func (x *GetEnumResponse) String() string {
 func (*GetEnumResponse) ProtoMessage() {}
 
 func (x *GetEnumResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[98]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5302,7 +5433,7 @@ func (x *GetEnumResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnumResponse.ProtoReflect.Descriptor instead.
 func (*GetEnumResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{98}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *GetEnumResponse) GetName() string {
@@ -5342,7 +5473,7 @@ type GetFunctionInfoRequest struct {
 
 func (x *GetFunctionInfoRequest) Reset() {
 	*x = GetFunctionInfoRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[99]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5354,7 +5485,7 @@ func (x *GetFunctionInfoRequest) String() string {
 func (*GetFunctionInfoRequest) ProtoMessage() {}
 
 func (x *GetFunctionInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[99]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5367,7 +5498,7 @@ func (x *GetFunctionInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetFunctionInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{99}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetFunctionInfoRequest) GetAddress() uint64 {
@@ -5390,7 +5521,7 @@ type FunctionFlags struct {
 
 func (x *FunctionFlags) Reset() {
 	*x = FunctionFlags{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[100]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5402,7 +5533,7 @@ func (x *FunctionFlags) String() string {
 func (*FunctionFlags) ProtoMessage() {}
 
 func (x *FunctionFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[100]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5415,7 +5546,7 @@ func (x *FunctionFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FunctionFlags.ProtoReflect.Descriptor instead.
 func (*FunctionFlags) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{100}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *FunctionFlags) GetIsLibrary() bool {
@@ -5472,7 +5603,7 @@ type GetFunctionInfoResponse struct {
 
 func (x *GetFunctionInfoResponse) Reset() {
 	*x = GetFunctionInfoResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[101]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5484,7 +5615,7 @@ func (x *GetFunctionInfoResponse) String() string {
 func (*GetFunctionInfoResponse) ProtoMessage() {}
 
 func (x *GetFunctionInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[101]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5497,7 +5628,7 @@ func (x *GetFunctionInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFunctionInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetFunctionInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{101}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetFunctionInfoResponse) GetAddress() uint64 {
@@ -5586,7 +5717,7 @@ type GetTypeAtRequest struct {
 
 func (x *GetTypeAtRequest) Reset() {
 	*x = GetTypeAtRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[102]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5598,7 +5729,7 @@ func (x *GetTypeAtRequest) String() string {
 func (*GetTypeAtRequest) ProtoMessage() {}
 
 func (x *GetTypeAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[102]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5611,7 +5742,7 @@ func (x *GetTypeAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTypeAtRequest.ProtoReflect.Descriptor instead.
 func (*GetTypeAtRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{102}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetTypeAtRequest) GetAddress() uint64 {
@@ -5640,7 +5771,7 @@ type GetTypeAtResponse struct {
 
 func (x *GetTypeAtResponse) Reset() {
 	*x = GetTypeAtResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[103]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5652,7 +5783,7 @@ func (x *GetTypeAtResponse) String() string {
 func (*GetTypeAtResponse) ProtoMessage() {}
 
 func (x *GetTypeAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[103]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5665,7 +5796,7 @@ func (x *GetTypeAtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTypeAtResponse.ProtoReflect.Descriptor instead.
 func (*GetTypeAtResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{103}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetTypeAtResponse) GetAddress() uint64 {
@@ -5757,7 +5888,7 @@ type FindBinaryRequest struct {
 
 func (x *FindBinaryRequest) Reset() {
 	*x = FindBinaryRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[104]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5769,7 +5900,7 @@ func (x *FindBinaryRequest) String() string {
 func (*FindBinaryRequest) ProtoMessage() {}
 
 func (x *FindBinaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[104]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5782,7 +5913,7 @@ func (x *FindBinaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindBinaryRequest.ProtoReflect.Descriptor instead.
 func (*FindBinaryRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{104}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *FindBinaryRequest) GetStart() uint64 {
@@ -5823,7 +5954,7 @@ type FindBinaryResponse struct {
 
 func (x *FindBinaryResponse) Reset() {
 	*x = FindBinaryResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[105]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5835,7 +5966,7 @@ func (x *FindBinaryResponse) String() string {
 func (*FindBinaryResponse) ProtoMessage() {}
 
 func (x *FindBinaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[105]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5848,7 +5979,7 @@ func (x *FindBinaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindBinaryResponse.ProtoReflect.Descriptor instead.
 func (*FindBinaryResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{105}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *FindBinaryResponse) GetAddresses() []uint64 {
@@ -5878,7 +6009,7 @@ type FindTextRequest struct {
 
 func (x *FindTextRequest) Reset() {
 	*x = FindTextRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[106]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5890,7 +6021,7 @@ func (x *FindTextRequest) String() string {
 func (*FindTextRequest) ProtoMessage() {}
 
 func (x *FindTextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[106]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5903,7 +6034,7 @@ func (x *FindTextRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindTextRequest.ProtoReflect.Descriptor instead.
 func (*FindTextRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{106}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *FindTextRequest) GetStart() uint64 {
@@ -5951,7 +6082,7 @@ type FindTextResponse struct {
 
 func (x *FindTextResponse) Reset() {
 	*x = FindTextResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[107]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5963,7 +6094,7 @@ func (x *FindTextResponse) String() string {
 func (*FindTextResponse) ProtoMessage() {}
 
 func (x *FindTextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[107]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,7 +6107,7 @@ func (x *FindTextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindTextResponse.ProtoReflect.Descriptor instead.
 func (*FindTextResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{107}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *FindTextResponse) GetAddresses() []uint64 {
@@ -6003,7 +6134,7 @@ type GetFuncCommentRequest struct {
 
 func (x *GetFuncCommentRequest) Reset() {
 	*x = GetFuncCommentRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[108]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6015,7 +6146,7 @@ func (x *GetFuncCommentRequest) String() string {
 func (*GetFuncCommentRequest) ProtoMessage() {}
 
 func (x *GetFuncCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[108]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6028,7 +6159,7 @@ func (x *GetFuncCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFuncCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetFuncCommentRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{108}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetFuncCommentRequest) GetAddress() uint64 {
@@ -6049,7 +6180,7 @@ type GetFuncCommentResponse struct {
 
 func (x *GetFuncCommentResponse) Reset() {
 	*x = GetFuncCommentResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[109]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6061,7 +6192,7 @@ func (x *GetFuncCommentResponse) String() string {
 func (*GetFuncCommentResponse) ProtoMessage() {}
 
 func (x *GetFuncCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[109]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6074,7 +6205,7 @@ func (x *GetFuncCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFuncCommentResponse.ProtoReflect.Descriptor instead.
 func (*GetFuncCommentResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{109}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetFuncCommentResponse) GetComment() string {
@@ -6102,7 +6233,7 @@ type SetNameRequest struct {
 
 func (x *SetNameRequest) Reset() {
 	*x = SetNameRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[110]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6114,7 +6245,7 @@ func (x *SetNameRequest) String() string {
 func (*SetNameRequest) ProtoMessage() {}
 
 func (x *SetNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[110]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6127,7 +6258,7 @@ func (x *SetNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNameRequest.ProtoReflect.Descriptor instead.
 func (*SetNameRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{110}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *SetNameRequest) GetAddress() uint64 {
@@ -6155,7 +6286,7 @@ type SetNameResponse struct {
 
 func (x *SetNameResponse) Reset() {
 	*x = SetNameResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[111]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6167,7 +6298,7 @@ func (x *SetNameResponse) String() string {
 func (*SetNameResponse) ProtoMessage() {}
 
 func (x *SetNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[111]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6180,7 +6311,7 @@ func (x *SetNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNameResponse.ProtoReflect.Descriptor instead.
 func (*SetNameResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{111}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *SetNameResponse) GetSuccess() bool {
@@ -6207,7 +6338,7 @@ type GetNameRequest struct {
 
 func (x *GetNameRequest) Reset() {
 	*x = GetNameRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[112]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6219,7 +6350,7 @@ func (x *GetNameRequest) String() string {
 func (*GetNameRequest) ProtoMessage() {}
 
 func (x *GetNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[112]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6232,7 +6363,7 @@ func (x *GetNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNameRequest.ProtoReflect.Descriptor instead.
 func (*GetNameRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{112}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetNameRequest) GetAddress() uint64 {
@@ -6253,7 +6384,7 @@ type GetNameResponse struct {
 
 func (x *GetNameResponse) Reset() {
 	*x = GetNameResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[113]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6265,7 +6396,7 @@ func (x *GetNameResponse) String() string {
 func (*GetNameResponse) ProtoMessage() {}
 
 func (x *GetNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[113]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6278,7 +6409,7 @@ func (x *GetNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNameResponse.ProtoReflect.Descriptor instead.
 func (*GetNameResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{113}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetNameResponse) GetName() string {
@@ -6305,7 +6436,7 @@ type DeleteNameRequest struct {
 
 func (x *DeleteNameRequest) Reset() {
 	*x = DeleteNameRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[114]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6317,7 +6448,7 @@ func (x *DeleteNameRequest) String() string {
 func (*DeleteNameRequest) ProtoMessage() {}
 
 func (x *DeleteNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[114]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6330,7 +6461,7 @@ func (x *DeleteNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNameRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNameRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{114}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *DeleteNameRequest) GetAddress() uint64 {
@@ -6351,7 +6482,7 @@ type DeleteNameResponse struct {
 
 func (x *DeleteNameResponse) Reset() {
 	*x = DeleteNameResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[115]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6363,7 +6494,7 @@ func (x *DeleteNameResponse) String() string {
 func (*DeleteNameResponse) ProtoMessage() {}
 
 func (x *DeleteNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[115]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6376,7 +6507,7 @@ func (x *DeleteNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNameResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNameResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{115}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *DeleteNameResponse) GetSuccess() bool {
@@ -6404,7 +6535,7 @@ type SetFunctionTypeRequest struct {
 
 func (x *SetFunctionTypeRequest) Reset() {
 	*x = SetFunctionTypeRequest{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[116]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6416,7 +6547,7 @@ func (x *SetFunctionTypeRequest) String() string {
 func (*SetFunctionTypeRequest) ProtoMessage() {}
 
 func (x *SetFunctionTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[116]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6560,7 @@ func (x *SetFunctionTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFunctionTypeRequest.ProtoReflect.Descriptor instead.
 func (*SetFunctionTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{116}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *SetFunctionTypeRequest) GetAddress() uint64 {
@@ -6457,7 +6588,7 @@ type SetFunctionTypeResponse struct {
 
 func (x *SetFunctionTypeResponse) Reset() {
 	*x = SetFunctionTypeResponse{}
-	mi := &file_ida_worker_v1_service_proto_msgTypes[117]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6469,7 +6600,7 @@ func (x *SetFunctionTypeResponse) String() string {
 func (*SetFunctionTypeResponse) ProtoMessage() {}
 
 func (x *SetFunctionTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ida_worker_v1_service_proto_msgTypes[117]
+	mi := &file_ida_worker_v1_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6482,7 +6613,7 @@ func (x *SetFunctionTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFunctionTypeResponse.ProtoReflect.Descriptor instead.
 func (*SetFunctionTypeResponse) Descriptor() ([]byte, []int) {
-	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{117}
+	return file_ida_worker_v1_service_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *SetFunctionTypeResponse) GetSuccess() bool {
@@ -6503,17 +6634,20 @@ var File_ida_worker_v1_service_proto protoreflect.FileDescriptor
 
 const file_ida_worker_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bida/worker/v1/service.proto\x12\rida.worker.v1\"W\n" +
+	"\x1bida/worker/v1/service.proto\x12\rida.worker.v1\"|\n" +
 	"\x11OpenBinaryRequest\x12\x1f\n" +
 	"\vbinary_path\x18\x01 \x01(\tR\n" +
 	"binaryPath\x12!\n" +
-	"\fauto_analyze\x18\x02 \x01(\bR\vautoAnalyze\"\x8c\x01\n" +
+	"\fauto_analyze\x18\x02 \x01(\bR\vautoAnalyze\x12#\n" +
+	"\rdatabase_path\x18\x03 \x01(\tR\fdatabasePath\"\xc4\x01\n" +
 	"\x12OpenBinaryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12%\n" +
 	"\x0ehas_decompiler\x18\x03 \x01(\bR\rhasDecompiler\x12\x1f\n" +
 	"\vbinary_path\x18\x04 \x01(\tR\n" +
-	"binaryPath\")\n" +
+	"binaryPath\x12\x1c\n" +
+	"\tprocessor\x18\x05 \x01(\tR\tprocessor\x12\x18\n" +
+	"\abitness\x18\x06 \x01(\x05R\abitness\")\n" +
 	"\x13CloseSessionRequest\x12\x12\n" +
 	"\x04save\x18\x01 \x01(\bR\x04save\"F\n" +
 	"\x14CloseSessionResponse\x12\x18\n" +
@@ -6539,7 +6673,14 @@ const file_ida_worker_v1_service_proto_rawDesc = "" +
 	"\x0ehas_decompiler\x18\x04 \x01(\bR\rhasDecompiler\x12!\n" +
 	"\fauto_running\x18\x05 \x01(\bR\vautoRunning\x12\x1d\n" +
 	"\n" +
-	"auto_state\x18\x06 \x01(\tR\tautoState\"?\n" +
+	"auto_state\x18\x06 \x01(\tR\tautoState\"G\n" +
+	"\rCancelRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\await_ms\x18\x02 \x01(\rR\x06waitMs\"@\n" +
+	"\x0eCancelResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x18\n" +
+	"\astopped\x18\x02 \x01(\bR\astopped\"?\n" +
 	"\x0fGetBytesRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\x04R\aaddress\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\rR\x04size\"<\n" +
@@ -6910,14 +7051,15 @@ const file_ida_worker_v1_service_proto_rawDesc = "" +
 	"\tprototype\x18\x02 \x01(\tR\tprototype\"I\n" +
 	"\x17SetFunctionTypeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error2\xca\x03\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\x91\x04\n" +
 	"\x0eSessionControl\x12Q\n" +
 	"\n" +
 	"OpenBinary\x12 .ida.worker.v1.OpenBinaryRequest\x1a!.ida.worker.v1.OpenBinaryResponse\x12W\n" +
 	"\fCloseSession\x12\".ida.worker.v1.CloseSessionRequest\x1a#.ida.worker.v1.CloseSessionResponse\x12W\n" +
 	"\fSaveDatabase\x12\".ida.worker.v1.SaveDatabaseRequest\x1a#.ida.worker.v1.SaveDatabaseResponse\x12T\n" +
 	"\vPlanAndWait\x12!.ida.worker.v1.PlanAndWaitRequest\x1a\".ida.worker.v1.PlanAndWaitResponse\x12]\n" +
-	"\x0eGetSessionInfo\x12$.ida.worker.v1.GetSessionInfoRequest\x1a%.ida.worker.v1.GetSessionInfoResponse2\xf5\x1e\n" +
+	"\x0eGetSessionInfo\x12$.ida.worker.v1.GetSessionInfoRequest\x1a%.ida.worker.v1.GetSessionInfoResponse\x12E\n" +
+	"\x06Cancel\x12\x1c.ida.worker.v1.CancelRequest\x1a\x1d.ida.worker.v1.CancelResponse2\xf5\x1e\n" +
 	"\rAnalysisTools\x12K\n" +
 	"\bGetBytes\x12\x1e.ida.worker.v1.GetBytesRequest\x1a\x1f.ida.worker.v1.GetBytesResponse\x12N\n" +
 	"\tGetDisasm\x12\x1f.ida.worker.v1.GetDisasmRequest\x1a .ida.worker.v1.GetDisasmResponse\x12f\n" +
@@ -6992,7 +7134,7 @@ func file_ida_worker_v1_service_proto_rawDescGZIP() []byte {
 	return file_ida_worker_v1_service_proto_rawDescData
 }
 
-var file_ida_worker_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_ida_worker_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
 var file_ida_worker_v1_service_proto_goTypes = []any{
 	(*OpenBinaryRequest)(nil),            // 0: ida.worker.v1.OpenBinaryRequest
 	(*OpenBinaryResponse)(nil),           // 1: ida.worker.v1.OpenBinaryResponse
@@ -7004,237 +7146,241 @@ var file_ida_worker_v1_service_proto_goTypes = []any{
 	(*PlanAndWaitResponse)(nil),          // 7: ida.worker.v1.PlanAndWaitResponse
 	(*GetSessionInfoRequest)(nil),        // 8: ida.worker.v1.GetSessionInfoRequest
 	(*GetSessionInfoResponse)(nil),       // 9: ida.worker.v1.GetSessionInfoResponse
-	(*GetBytesRequest)(nil),              // 10: ida.worker.v1.GetBytesRequest
-	(*GetBytesResponse)(nil),             // 11: ida.worker.v1.GetBytesResponse
-	(*GetDisasmRequest)(nil),             // 12: ida.worker.v1.GetDisasmRequest
-	(*GetDisasmResponse)(nil),            // 13: ida.worker.v1.GetDisasmResponse
-	(*GetFunctionDisasmRequest)(nil),     // 14: ida.worker.v1.GetFunctionDisasmRequest
-	(*GetFunctionDisasmResponse)(nil),    // 15: ida.worker.v1.GetFunctionDisasmResponse
-	(*GetDecompiledRequest)(nil),         // 16: ida.worker.v1.GetDecompiledRequest
-	(*GetDecompiledResponse)(nil),        // 17: ida.worker.v1.GetDecompiledResponse
-	(*GetFunctionNameRequest)(nil),       // 18: ida.worker.v1.GetFunctionNameRequest
-	(*GetFunctionNameResponse)(nil),      // 19: ida.worker.v1.GetFunctionNameResponse
-	(*GetSegmentsRequest)(nil),           // 20: ida.worker.v1.GetSegmentsRequest
-	(*Segment)(nil),                      // 21: ida.worker.v1.Segment
-	(*GetSegmentsResponse)(nil),          // 22: ida.worker.v1.GetSegmentsResponse
-	(*GetFunctionsRequest)(nil),          // 23: ida.worker.v1.GetFunctionsRequest
-	(*Function)(nil),                     // 24: ida.worker.v1.Function
-	(*GetFunctionsResponse)(nil),         // 25: ida.worker.v1.GetFunctionsResponse
-	(*GetXRefsToRequest)(nil),            // 26: ida.worker.v1.GetXRefsToRequest
-	(*XRef)(nil),                         // 27: ida.worker.v1.XRef
-	(*GetXRefsToResponse)(nil),           // 28: ida.worker.v1.GetXRefsToResponse
-	(*GetXRefsFromRequest)(nil),          // 29: ida.worker.v1.GetXRefsFromRequest
-	(*GetXRefsFromResponse)(nil),         // 30: ida.worker.v1.GetXRefsFromResponse
-	(*GetDataRefsRequest)(nil),           // 31: ida.worker.v1.GetDataRefsRequest
-	(*DataRef)(nil),                      // 32: ida.worker.v1.DataRef
-	(*GetDataRefsResponse)(nil),          // 33: ida.worker.v1.GetDataRefsResponse
-	(*GetStringXRefsRequest)(nil),        // 34: ida.worker.v1.GetStringXRefsRequest
-	(*StringXRef)(nil),                   // 35: ida.worker.v1.StringXRef
-	(*GetStringXRefsResponse)(nil),       // 36: ida.worker.v1.GetStringXRefsResponse
-	(*GetImportsRequest)(nil),            // 37: ida.worker.v1.GetImportsRequest
-	(*Import)(nil),                       // 38: ida.worker.v1.Import
-	(*GetImportsResponse)(nil),           // 39: ida.worker.v1.GetImportsResponse
-	(*GetExportsRequest)(nil),            // 40: ida.worker.v1.GetExportsRequest
-	(*Export)(nil),                       // 41: ida.worker.v1.Export
-	(*GetExportsResponse)(nil),           // 42: ida.worker.v1.GetExportsResponse
-	(*GetEntryPointRequest)(nil),         // 43: ida.worker.v1.GetEntryPointRequest
-	(*GetEntryPointResponse)(nil),        // 44: ida.worker.v1.GetEntryPointResponse
-	(*GetStringsRequest)(nil),            // 45: ida.worker.v1.GetStringsRequest
-	(*StringItem)(nil),                   // 46: ida.worker.v1.StringItem
-	(*GetStringsResponse)(nil),           // 47: ida.worker.v1.GetStringsResponse
-	(*MakeFunctionRequest)(nil),          // 48: ida.worker.v1.MakeFunctionRequest
-	(*MakeFunctionResponse)(nil),         // 49: ida.worker.v1.MakeFunctionResponse
-	(*ImportIl2CppRequest)(nil),          // 50: ida.worker.v1.ImportIl2CppRequest
-	(*ImportIl2CppResponse)(nil),         // 51: ida.worker.v1.ImportIl2CppResponse
-	(*ImportFlutterRequest)(nil),         // 52: ida.worker.v1.ImportFlutterRequest
-	(*ImportFlutterResponse)(nil),        // 53: ida.worker.v1.ImportFlutterResponse
-	(*GetDwordAtRequest)(nil),            // 54: ida.worker.v1.GetDwordAtRequest
-	(*GetDwordAtResponse)(nil),           // 55: ida.worker.v1.GetDwordAtResponse
-	(*GetQwordAtRequest)(nil),            // 56: ida.worker.v1.GetQwordAtRequest
-	(*GetQwordAtResponse)(nil),           // 57: ida.worker.v1.GetQwordAtResponse
-	(*GetInstructionLengthRequest)(nil),  // 58: ida.worker.v1.GetInstructionLengthRequest
-	(*GetInstructionLengthResponse)(nil), // 59: ida.worker.v1.GetInstructionLengthResponse
-	(*PingRequest)(nil),                  // 60: ida.worker.v1.PingRequest
-	(*PingResponse)(nil),                 // 61: ida.worker.v1.PingResponse
-	(*StatusStreamRequest)(nil),          // 62: ida.worker.v1.StatusStreamRequest
-	(*WorkerStatus)(nil),                 // 63: ida.worker.v1.WorkerStatus
-	(*SetCommentRequest)(nil),            // 64: ida.worker.v1.SetCommentRequest
-	(*SetCommentResponse)(nil),           // 65: ida.worker.v1.SetCommentResponse
-	(*GetCommentRequest)(nil),            // 66: ida.worker.v1.GetCommentRequest
-	(*GetCommentResponse)(nil),           // 67: ida.worker.v1.GetCommentResponse
-	(*SetFuncCommentRequest)(nil),        // 68: ida.worker.v1.SetFuncCommentRequest
-	(*SetFuncCommentResponse)(nil),       // 69: ida.worker.v1.SetFuncCommentResponse
-	(*SetLvarTypeRequest)(nil),           // 70: ida.worker.v1.SetLvarTypeRequest
-	(*SetLvarTypeResponse)(nil),          // 71: ida.worker.v1.SetLvarTypeResponse
-	(*RenameLvarRequest)(nil),            // 72: ida.worker.v1.RenameLvarRequest
-	(*RenameLvarResponse)(nil),           // 73: ida.worker.v1.RenameLvarResponse
-	(*SetDecompilerCommentRequest)(nil),  // 74: ida.worker.v1.SetDecompilerCommentRequest
-	(*SetDecompilerCommentResponse)(nil), // 75: ida.worker.v1.SetDecompilerCommentResponse
-	(*GetGlobalsRequest)(nil),            // 76: ida.worker.v1.GetGlobalsRequest
-	(*GlobalVariable)(nil),               // 77: ida.worker.v1.GlobalVariable
-	(*GetGlobalsResponse)(nil),           // 78: ida.worker.v1.GetGlobalsResponse
-	(*SetGlobalTypeRequest)(nil),         // 79: ida.worker.v1.SetGlobalTypeRequest
-	(*SetGlobalTypeResponse)(nil),        // 80: ida.worker.v1.SetGlobalTypeResponse
-	(*RenameGlobalRequest)(nil),          // 81: ida.worker.v1.RenameGlobalRequest
-	(*RenameGlobalResponse)(nil),         // 82: ida.worker.v1.RenameGlobalResponse
-	(*DataReadStringRequest)(nil),        // 83: ida.worker.v1.DataReadStringRequest
-	(*DataReadStringResponse)(nil),       // 84: ida.worker.v1.DataReadStringResponse
-	(*DataReadByteRequest)(nil),          // 85: ida.worker.v1.DataReadByteRequest
-	(*DataReadByteResponse)(nil),         // 86: ida.worker.v1.DataReadByteResponse
-	(*ListStructsRequest)(nil),           // 87: ida.worker.v1.ListStructsRequest
-	(*StructSummary)(nil),                // 88: ida.worker.v1.StructSummary
-	(*ListStructsResponse)(nil),          // 89: ida.worker.v1.ListStructsResponse
-	(*GetStructRequest)(nil),             // 90: ida.worker.v1.GetStructRequest
-	(*StructMember)(nil),                 // 91: ida.worker.v1.StructMember
-	(*GetStructResponse)(nil),            // 92: ida.worker.v1.GetStructResponse
-	(*ListEnumsRequest)(nil),             // 93: ida.worker.v1.ListEnumsRequest
-	(*EnumSummary)(nil),                  // 94: ida.worker.v1.EnumSummary
-	(*ListEnumsResponse)(nil),            // 95: ida.worker.v1.ListEnumsResponse
-	(*GetEnumRequest)(nil),               // 96: ida.worker.v1.GetEnumRequest
-	(*EnumMember)(nil),                   // 97: ida.worker.v1.EnumMember
-	(*GetEnumResponse)(nil),              // 98: ida.worker.v1.GetEnumResponse
-	(*GetFunctionInfoRequest)(nil),       // 99: ida.worker.v1.GetFunctionInfoRequest
-	(*FunctionFlags)(nil),                // 100: ida.worker.v1.FunctionFlags
-	(*GetFunctionInfoResponse)(nil),      // 101: ida.worker.v1.GetFunctionInfoResponse
-	(*GetTypeAtRequest)(nil),             // 102: ida.worker.v1.GetTypeAtRequest
-	(*GetTypeAtResponse)(nil),            // 103: ida.worker.v1.GetTypeAtResponse
-	(*FindBinaryRequest)(nil),            // 104: ida.worker.v1.FindBinaryRequest
-	(*FindBinaryResponse)(nil),           // 105: ida.worker.v1.FindBinaryResponse
-	(*FindTextRequest)(nil),              // 106: ida.worker.v1.FindTextRequest
-	(*FindTextResponse)(nil),             // 107: ida.worker.v1.FindTextResponse
-	(*GetFuncCommentRequest)(nil),        // 108: ida.worker.v1.GetFuncCommentRequest
-	(*GetFuncCommentResponse)(nil),       // 109: ida.worker.v1.GetFuncCommentResponse
-	(*SetNameRequest)(nil),               // 110: ida.worker.v1.SetNameRequest
-	(*SetNameResponse)(nil),              // 111: ida.worker.v1.SetNameResponse
-	(*GetNameRequest)(nil),               // 112: ida.worker.v1.GetNameRequest
-	(*GetNameResponse)(nil),              // 113: ida.worker.v1.GetNameResponse
-	(*DeleteNameRequest)(nil),            // 114: ida.worker.v1.DeleteNameRequest
-	(*DeleteNameResponse)(nil),           // 115: ida.worker.v1.DeleteNameResponse
-	(*SetFunctionTypeRequest)(nil),       // 116: ida.worker.v1.SetFunctionTypeRequest
-	(*SetFunctionTypeResponse)(nil),      // 117: ida.worker.v1.SetFunctionTypeResponse
+	(*CancelRequest)(nil),                // 10: ida.worker.v1.CancelRequest
+	(*CancelResponse)(nil),               // 11: ida.worker.v1.CancelResponse
+	(*GetBytesRequest)(nil),              // 12: ida.worker.v1.GetBytesRequest
+	(*GetBytesResponse)(nil),             // 13: ida.worker.v1.GetBytesResponse
+	(*GetDisasmRequest)(nil),             // 14: ida.worker.v1.GetDisasmRequest
+	(*GetDisasmResponse)(nil),            // 15: ida.worker.v1.GetDisasmResponse
+	(*GetFunctionDisasmRequest)(nil),     // 16: ida.worker.v1.GetFunctionDisasmRequest
+	(*GetFunctionDisasmResponse)(nil),    // 17: ida.worker.v1.GetFunctionDisasmResponse
+	(*GetDecompiledRequest)(nil),         // 18: ida.worker.v1.GetDecompiledRequest
+	(*GetDecompiledResponse)(nil),        // 19: ida.worker.v1.GetDecompiledResponse
+	(*GetFunctionNameRequest)(nil),       // 20: ida.worker.v1.GetFunctionNameRequest
+	(*GetFunctionNameResponse)(nil),      // 21: ida.worker.v1.GetFunctionNameResponse
+	(*GetSegmentsRequest)(nil),           // 22: ida.worker.v1.GetSegmentsRequest
+	(*Segment)(nil),                      // 23: ida.worker.v1.Segment
+	(*GetSegmentsResponse)(nil),          // 24: ida.worker.v1.GetSegmentsResponse
+	(*GetFunctionsRequest)(nil),          // 25: ida.worker.v1.GetFunctionsRequest
+	(*Function)(nil),                     // 26: ida.worker.v1.Function
+	(*GetFunctionsResponse)(nil),         // 27: ida.worker.v1.GetFunctionsResponse
+	(*GetXRefsToRequest)(nil),            // 28: ida.worker.v1.GetXRefsToRequest
+	(*XRef)(nil),                         // 29: ida.worker.v1.XRef
+	(*GetXRefsToResponse)(nil),           // 30: ida.worker.v1.GetXRefsToResponse
+	(*GetXRefsFromRequest)(nil),          // 31: ida.worker.v1.GetXRefsFromRequest
+	(*GetXRefsFromResponse)(nil),         // 32: ida.worker.v1.GetXRefsFromResponse
+	(*GetDataRefsRequest)(nil),           // 33: ida.worker.v1.GetDataRefsRequest
+	(*DataRef)(nil),                      // 34: ida.worker.v1.DataRef
+	(*GetDataRefsResponse)(nil),          // 35: ida.worker.v1.GetDataRefsResponse
+	(*GetStringXRefsRequest)(nil),        // 36: ida.worker.v1.GetStringXRefsRequest
+	(*StringXRef)(nil),                   // 37: ida.worker.v1.StringXRef
+	(*GetStringXRefsResponse)(nil),       // 38: ida.worker.v1.GetStringXRefsResponse
+	(*GetImportsRequest)(nil),            // 39: ida.worker.v1.GetImportsRequest
+	(*Import)(nil),                       // 40: ida.worker.v1.Import
+	(*GetImportsResponse)(nil),           // 41: ida.worker.v1.GetImportsResponse
+	(*GetExportsRequest)(nil),            // 42: ida.worker.v1.GetExportsRequest
+	(*Export)(nil),                       // 43: ida.worker.v1.Export
+	(*GetExportsResponse)(nil),           // 44: ida.worker.v1.GetExportsResponse
+	(*GetEntryPointRequest)(nil),         // 45: ida.worker.v1.GetEntryPointRequest
+	(*GetEntryPointResponse)(nil),        // 46: ida.worker.v1.GetEntryPointResponse
+	(*GetStringsRequest)(nil),            // 47: ida.worker.v1.GetStringsRequest
+	(*StringItem)(nil),                   // 48: ida.worker.v1.StringItem
+	(*GetStringsResponse)(nil),           // 49: ida.worker.v1.GetStringsResponse
+	(*MakeFunctionRequest)(nil),          // 50: ida.worker.v1.MakeFunctionRequest
+	(*MakeFunctionResponse)(nil),         // 51: ida.worker.v1.MakeFunctionResponse
+	(*ImportIl2CppRequest)(nil),          // 52: ida.worker.v1.ImportIl2CppRequest
+	(*ImportIl2CppResponse)(nil),         // 53: ida.worker.v1.ImportIl2CppResponse
+	(*ImportFlutterRequest)(nil),         // 54: ida.worker.v1.ImportFlutterRequest
+	(*ImportFlutterResponse)(nil),        // 55: ida.worker.v1.ImportFlutterResponse
+	(*GetDwordAtRequest)(nil),            // 56: ida.worker.v1.GetDwordAtRequest
+	(*GetDwordAtResponse)(nil),           // 57: ida.worker.v1.GetDwordAtResponse
+	(*GetQwordAtRequest)(nil),            // 58: ida.worker.v1.GetQwordAtRequest
+	(*GetQwordAtResponse)(nil),           // 59: ida.worker.v1.GetQwordAtResponse
+	(*GetInstructionLengthRequest)(nil),  // 60: ida.worker.v1.GetInstructionLengthRequest
+	(*GetInstructionLengthResponse)(nil), // 61: ida.worker.v1.GetInstructionLengthResponse
+	(*PingRequest)(nil),                  // 62: ida.worker.v1.PingRequest
+	(*PingResponse)(nil),                 // 63: ida.worker.v1.PingResponse
+	(*StatusStreamRequest)(nil),          // 64: ida.worker.v1.StatusStreamRequest
+	(*WorkerStatus)(nil),                 // 65: ida.worker.v1.WorkerStatus
+	(*SetCommentRequest)(nil),            // 66: ida.worker.v1.SetCommentRequest
+	(*SetCommentResponse)(nil),           // 67: ida.worker.v1.SetCommentResponse
+	(*GetCommentRequest)(nil),            // 68: ida.worker.v1.GetCommentRequest
+	(*GetCommentResponse)(nil),           // 69: ida.worker.v1.GetCommentResponse
+	(*SetFuncCommentRequest)(nil),        // 70: ida.worker.v1.SetFuncCommentRequest
+	(*SetFuncCommentResponse)(nil),       // 71: ida.worker.v1.SetFuncCommentResponse
+	(*SetLvarTypeRequest)(nil),           // 72: ida.worker.v1.SetLvarTypeRequest
+	(*SetLvarTypeResponse)(nil),          // 73: ida.worker.v1.SetLvarTypeResponse
+	(*RenameLvarRequest)(nil),            // 74: ida.worker.v1.RenameLvarRequest
+	(*RenameLvarResponse)(nil),           // 75: ida.worker.v1.RenameLvarResponse
+	(*SetDecompilerCommentRequest)(nil),  // 76: ida.worker.v1.SetDecompilerCommentRequest
+	(*SetDecompilerCommentResponse)(nil), // 77: ida.worker.v1.SetDecompilerCommentResponse
+	(*GetGlobalsRequest)(nil),            // 78: ida.worker.v1.GetGlobalsRequest
+	(*GlobalVariable)(nil),               // 79: ida.worker.v1.GlobalVariable
+	(*GetGlobalsResponse)(nil),           // 80: ida.worker.v1.GetGlobalsResponse
+	(*SetGlobalTypeRequest)(nil),         // 81: ida.worker.v1.SetGlobalTypeRequest
+	(*SetGlobalTypeResponse)(nil),        // 82: ida.worker.v1.SetGlobalTypeResponse
+	(*RenameGlobalRequest)(nil),          // 83: ida.worker.v1.RenameGlobalRequest
+	(*RenameGlobalResponse)(nil),         // 84: ida.worker.v1.RenameGlobalResponse
+	(*DataReadStringRequest)(nil),        // 85: ida.worker.v1.DataReadStringRequest
+	(*DataReadStringResponse)(nil),       // 86: ida.worker.v1.DataReadStringResponse
+	(*DataReadByteRequest)(nil),          // 87: ida.worker.v1.DataReadByteRequest
+	(*DataReadByteResponse)(nil),         // 88: ida.worker.v1.DataReadByteResponse
+	(*ListStructsRequest)(nil),           // 89: ida.worker.v1.ListStructsRequest
+	(*StructSummary)(nil),                // 90: ida.worker.v1.StructSummary
+	(*ListStructsResponse)(nil),          // 91: ida.worker.v1.ListStructsResponse
+	(*GetStructRequest)(nil),             // 92: ida.worker.v1.GetStructRequest
+	(*StructMember)(nil),                 // 93: ida.worker.v1.StructMember
+	(*GetStructResponse)(nil),            // 94: ida.worker.v1.GetStructResponse
+	(*ListEnumsRequest)(nil),             // 95: ida.worker.v1.ListEnumsRequest
+	(*EnumSummary)(nil),                  // 96: ida.worker.v1.EnumSummary
+	(*ListEnumsResponse)(nil),            // 97: ida.worker.v1.ListEnumsResponse
+	(*GetEnumRequest)(nil),               // 98: ida.worker.v1.GetEnumRequest
+	(*EnumMember)(nil),                   // 99: ida.worker.v1.EnumMember
+	(*GetEnumResponse)(nil),              // 100: ida.worker.v1.GetEnumResponse
+	(*GetFunctionInfoRequest)(nil),       // 101: ida.worker.v1.GetFunctionInfoRequest
+	(*FunctionFlags)(nil),                // 102: ida.worker.v1.FunctionFlags
+	(*GetFunctionInfoResponse)(nil),      // 103: ida.worker.v1.GetFunctionInfoResponse
+	(*GetTypeAtRequest)(nil),             // 104: ida.worker.v1.GetTypeAtRequest
+	(*GetTypeAtResponse)(nil),            // 105: ida.worker.v1.GetTypeAtResponse
+	(*FindBinaryRequest)(nil),            // 106: ida.worker.v1.FindBinaryRequest
+	(*FindBinaryResponse)(nil),           // 107: ida.worker.v1.FindBinaryResponse
+	(*FindTextRequest)(nil),              // 108: ida.worker.v1.FindTextRequest
+	(*FindTextResponse)(nil),             // 109: ida.worker.v1.FindTextResponse
+	(*GetFuncCommentRequest)(nil),        // 110: ida.worker.v1.GetFuncCommentRequest
+	(*GetFuncCommentResponse)(nil),       // 111: ida.worker.v1.GetFuncCommentResponse
+	(*SetNameRequest)(nil),               // 112: ida.worker.v1.SetNameRequest
+	(*SetNameResponse)(nil),              // 113: ida.worker.v1.SetNameResponse
+	(*GetNameRequest)(nil),               // 114: ida.worker.v1.GetNameRequest
+	(*GetNameResponse)(nil),              // 115: ida.worker.v1.GetNameResponse
+	(*DeleteNameRequest)(nil),            // 116: ida.worker.v1.DeleteNameRequest
+	(*DeleteNameResponse)(nil),           // 117: ida.worker.v1.DeleteNameResponse
+	(*SetFunctionTypeRequest)(nil),       // 118: ida.worker.v1.SetFunctionTypeRequest
+	(*SetFunctionTypeResponse)(nil),      // 119: ida.worker.v1.SetFunctionTypeResponse
 }
 var file_ida_worker_v1_service_proto_depIdxs = []int32{
-	21,  // 0: ida.worker.v1.GetSegmentsResponse.segments:type_name -> ida.worker.v1.Segment
-	24,  // 1: ida.worker.v1.GetFunctionsResponse.functions:type_name -> ida.worker.v1.Function
-	27,  // 2: ida.worker.v1.GetXRefsToResponse.xrefs:type_name -> ida.worker.v1.XRef
-	27,  // 3: ida.worker.v1.GetXRefsFromResponse.xrefs:type_name -> ida.worker.v1.XRef
-	32,  // 4: ida.worker.v1.GetDataRefsResponse.refs:type_name -> ida.worker.v1.DataRef
-	35,  // 5: ida.worker.v1.GetStringXRefsResponse.refs:type_name -> ida.worker.v1.StringXRef
-	38,  // 6: ida.worker.v1.GetImportsResponse.imports:type_name -> ida.worker.v1.Import
-	41,  // 7: ida.worker.v1.GetExportsResponse.exports:type_name -> ida.worker.v1.Export
-	46,  // 8: ida.worker.v1.GetStringsResponse.strings:type_name -> ida.worker.v1.StringItem
-	77,  // 9: ida.worker.v1.GetGlobalsResponse.globals:type_name -> ida.worker.v1.GlobalVariable
-	88,  // 10: ida.worker.v1.ListStructsResponse.structs:type_name -> ida.worker.v1.StructSummary
-	91,  // 11: ida.worker.v1.GetStructResponse.members:type_name -> ida.worker.v1.StructMember
-	94,  // 12: ida.worker.v1.ListEnumsResponse.enums:type_name -> ida.worker.v1.EnumSummary
-	97,  // 13: ida.worker.v1.GetEnumResponse.members:type_name -> ida.worker.v1.EnumMember
-	100, // 14: ida.worker.v1.GetFunctionInfoResponse.flags:type_name -> ida.worker.v1.FunctionFlags
+	23,  // 0: ida.worker.v1.GetSegmentsResponse.segments:type_name -> ida.worker.v1.Segment
+	26,  // 1: ida.worker.v1.GetFunctionsResponse.functions:type_name -> ida.worker.v1.Function
+	29,  // 2: ida.worker.v1.GetXRefsToResponse.xrefs:type_name -> ida.worker.v1.XRef
+	29,  // 3: ida.worker.v1.GetXRefsFromResponse.xrefs:type_name -> ida.worker.v1.XRef
+	34,  // 4: ida.worker.v1.GetDataRefsResponse.refs:type_name -> ida.worker.v1.DataRef
+	37,  // 5: ida.worker.v1.GetStringXRefsResponse.refs:type_name -> ida.worker.v1.StringXRef
+	40,  // 6: ida.worker.v1.GetImportsResponse.imports:type_name -> ida.worker.v1.Import
+	43,  // 7: ida.worker.v1.GetExportsResponse.exports:type_name -> ida.worker.v1.Export
+	48,  // 8: ida.worker.v1.GetStringsResponse.strings:type_name -> ida.worker.v1.StringItem
+	79,  // 9: ida.worker.v1.GetGlobalsResponse.globals:type_name -> ida.worker.v1.GlobalVariable
+	90,  // 10: ida.worker.v1.ListStructsResponse.structs:type_name -> ida.worker.v1.StructSummary
+	93,  // 11: ida.worker.v1.GetStructResponse.members:type_name -> ida.worker.v1.StructMember
+	96,  // 12: ida.worker.v1.ListEnumsResponse.enums:type_name -> ida.worker.v1.EnumSummary
+	99,  // 13: ida.worker.v1.GetEnumResponse.members:type_name -> ida.worker.v1.EnumMember
+	102, // 14: ida.worker.v1.GetFunctionInfoResponse.flags:type_name -> ida.worker.v1.FunctionFlags
 	0,   // 15: ida.worker.v1.SessionControl.OpenBinary:input_type -> ida.worker.v1.OpenBinaryRequest
 	2,   // 16: ida.worker.v1.SessionControl.CloseSession:input_type -> ida.worker.v1.CloseSessionRequest
 	4,   // 17: ida.worker.v1.SessionControl.SaveDatabase:input_type -> ida.worker.v1.SaveDatabaseRequest
 	6,   // 18: ida.worker.v1.SessionControl.PlanAndWait:input_type -> ida.worker.v1.PlanAndWaitRequest
 	8,   // 19: ida.worker.v1.SessionControl.GetSessionInfo:input_type -> ida.worker.v1.GetSessionInfoRequest
-	10,  // 20: ida.worker.v1.AnalysisTools.GetBytes:input_type -> ida.worker.v1.GetBytesRequest
-	12,  // 21: ida.worker.v1.AnalysisTools.GetDisasm:input_type -> ida.worker.v1.GetDisasmRequest
-	14,  // 22: ida.worker.v1.AnalysisTools.GetFunctionDisasm:input_type -> ida.worker.v1.GetFunctionDisasmRequest
-	16,  // 23: ida.worker.v1.AnalysisTools.GetDecompiled:input_type -> ida.worker.v1.GetDecompiledRequest
-	18,  // 24: ida.worker.v1.AnalysisTools.GetFunctionName:input_type -> ida.worker.v1.GetFunctionNameRequest
-	20,  // 25: ida.worker.v1.AnalysisTools.GetSegments:input_type -> ida.worker.v1.GetSegmentsRequest
-	23,  // 26: ida.worker.v1.AnalysisTools.GetFunctions:input_type -> ida.worker.v1.GetFunctionsRequest
-	26,  // 27: ida.worker.v1.AnalysisTools.GetXRefsTo:input_type -> ida.worker.v1.GetXRefsToRequest
-	29,  // 28: ida.worker.v1.AnalysisTools.GetXRefsFrom:input_type -> ida.worker.v1.GetXRefsFromRequest
-	31,  // 29: ida.worker.v1.AnalysisTools.GetDataRefs:input_type -> ida.worker.v1.GetDataRefsRequest
-	34,  // 30: ida.worker.v1.AnalysisTools.GetStringXRefs:input_type -> ida.worker.v1.GetStringXRefsRequest
-	37,  // 31: ida.worker.v1.AnalysisTools.GetImports:input_type -> ida.worker.v1.GetImportsRequest
-	40,  // 32: ida.worker.v1.AnalysisTools.GetExports:input_type -> ida.worker.v1.GetExportsRequest
-	43,  // 33: ida.worker.v1.AnalysisTools.GetEntryPoint:input_type -> ida.worker.v1.GetEntryPointRequest
-	45,  // 34: ida.worker.v1.AnalysisTools.GetStrings:input_type -> ida.worker.v1.GetStringsRequest
-	48,  // 35: ida.worker.v1.AnalysisTools.MakeFunction:input_type -> ida.worker.v1.MakeFunctionRequest
-	50,  // 36: ida.worker.v1.AnalysisTools.ImportIl2Cpp:input_type -> ida.worker.v1.ImportIl2CppRequest
-	52,  // 37: ida.worker.v1.AnalysisTools.ImportFlutter:input_type -> ida.worker.v1.ImportFlutterRequest
-	76,  // 38: ida.worker.v1.AnalysisTools.GetGlobals:input_type -> ida.worker.v1.GetGlobalsRequest
-	79,  // 39: ida.worker.v1.AnalysisTools.SetGlobalType:input_type -> ida.worker.v1.SetGlobalTypeRequest
-	81,  // 40: ida.worker.v1.AnalysisTools.RenameGlobal:input_type -> ida.worker.v1.RenameGlobalRequest
-	83,  // 41: ida.worker.v1.AnalysisTools.DataReadString:input_type -> ida.worker.v1.DataReadStringRequest
-	85,  // 42: ida.worker.v1.AnalysisTools.DataReadByte:input_type -> ida.worker.v1.DataReadByteRequest
-	104, // 43: ida.worker.v1.AnalysisTools.FindBinary:input_type -> ida.worker.v1.FindBinaryRequest
-	106, // 44: ida.worker.v1.AnalysisTools.FindText:input_type -> ida.worker.v1.FindTextRequest
-	87,  // 45: ida.worker.v1.AnalysisTools.ListStructs:input_type -> ida.worker.v1.ListStructsRequest
-	90,  // 46: ida.worker.v1.AnalysisTools.GetStruct:input_type -> ida.worker.v1.GetStructRequest
-	93,  // 47: ida.worker.v1.AnalysisTools.ListEnums:input_type -> ida.worker.v1.ListEnumsRequest
-	96,  // 48: ida.worker.v1.AnalysisTools.GetEnum:input_type -> ida.worker.v1.GetEnumRequest
-	99,  // 49: ida.worker.v1.AnalysisTools.GetFunctionInfo:input_type -> ida.worker.v1.GetFunctionInfoRequest
-	102, // 50: ida.worker.v1.AnalysisTools.GetTypeAt:input_type -> ida.worker.v1.GetTypeAtRequest
-	54,  // 51: ida.worker.v1.AnalysisTools.GetDwordAt:input_type -> ida.worker.v1.GetDwordAtRequest
-	56,  // 52: ida.worker.v1.AnalysisTools.GetQwordAt:input_type -> ida.worker.v1.GetQwordAtRequest
-	58,  // 53: ida.worker.v1.AnalysisTools.GetInstructionLength:input_type -> ida.worker.v1.GetInstructionLengthRequest
-	64,  // 54: ida.worker.v1.AnalysisTools.SetComment:input_type -> ida.worker.v1.SetCommentRequest
-	66,  // 55: ida.worker.v1.AnalysisTools.GetComment:input_type -> ida.worker.v1.GetCommentRequest
-	68,  // 56: ida.worker.v1.AnalysisTools.SetFuncComment:input_type -> ida.worker.v1.SetFuncCommentRequest
-	108, // 57: ida.worker.v1.AnalysisTools.GetFuncComment:input_type -> ida.worker.v1.GetFuncCommentRequest
-	70,  // 58: ida.worker.v1.AnalysisTools.SetLvarType:input_type -> ida.worker.v1.SetLvarTypeRequest
-	72,  // 59: ida.worker.v1.AnalysisTools.RenameLvar:input_type -> ida.worker.v1.RenameLvarRequest
-	74,  // 60: ida.worker.v1.AnalysisTools.SetDecompilerComment:input_type -> ida.worker.v1.SetDecompilerCommentRequest
-	110, // 61: ida.worker.v1.AnalysisTools.SetName:input_type -> ida.worker.v1.SetNameRequest
-	112, // 62: ida.worker.v1.AnalysisTools.GetName:input_type -> ida.worker.v1.GetNameRequest
-	114, // 63: ida.worker.v1.AnalysisTools.DeleteName:input_type -> ida.worker.v1.DeleteNameRequest
-	116, // 64: ida.worker.v1.AnalysisTools.SetFunctionType:input_type -> ida.worker.v1.SetFunctionTypeRequest
-	60,  // 65: ida.worker.v1.Healthcheck.Ping:input_type -> ida.worker.v1.PingRequest
-	62,  // 66: ida.worker.v1.Healthcheck.StatusStream:input_type -> ida.worker.v1.StatusStreamRequest
-	1,   // 67: ida.worker.v1.SessionControl.OpenBinary:output_type -> ida.worker.v1.OpenBinaryResponse
-	3,   // 68: ida.worker.v1.SessionControl.CloseSession:output_type -> ida.worker.v1.CloseSessionResponse
-	5,   // 69: ida.worker.v1.SessionControl.SaveDatabase:output_type -> ida.worker.v1.SaveDatabaseResponse
-	7,   // 70: ida.worker.v1.SessionControl.PlanAndWait:output_type -> ida.worker.v1.PlanAndWaitResponse
-	9,   // 71: ida.worker.v1.SessionControl.GetSessionInfo:output_type -> ida.worker.v1.GetSessionInfoResponse
-	11,  // 72: ida.worker.v1.AnalysisTools.GetBytes:output_type -> ida.worker.v1.GetBytesResponse
-	13,  // 73: ida.worker.v1.AnalysisTools.GetDisasm:output_type -> ida.worker.v1.GetDisasmResponse
-	15,  // 74: ida.worker.v1.AnalysisTools.GetFunctionDisasm:output_type -> ida.worker.v1.GetFunctionDisasmResponse
-	17,  // 75: ida.worker.v1.AnalysisTools.GetDecompiled:output_type -> ida.worker.v1.GetDecompiledResponse
-	19,  // 76: ida.worker.v1.AnalysisTools.GetFunctionName:output_type -> ida.worker.v1.GetFunctionNameResponse
-	22,  // 77: ida.worker.v1.AnalysisTools.GetSegments:output_type -> ida.worker.v1.GetSegmentsResponse
-	25,  // 78: ida.worker.v1.AnalysisTools.GetFunctions:output_type -> ida.worker.v1.GetFunctionsResponse
-	28,  // 79: ida.worker.v1.AnalysisTools.GetXRefsTo:output_type -> ida.worker.v1.GetXRefsToResponse
-	30,  // 80: ida.worker.v1.AnalysisTools.GetXRefsFrom:output_type -> ida.worker.v1.GetXRefsFromResponse
-	33,  // 81: ida.worker.v1.AnalysisTools.GetDataRefs:output_type -> ida.worker.v1.GetDataRefsResponse
-	36,  // 82: ida.worker.v1.AnalysisTools.GetStringXRefs:output_type -> ida.worker.v1.GetStringXRefsResponse
-	39,  // 83: ida.worker.v1.AnalysisTools.GetImports:output_type -> ida.worker.v1.GetImportsResponse
-	42,  // 84: ida.worker.v1.AnalysisTools.GetExports:output_type -> ida.worker.v1.GetExportsResponse
-	44,  // 85: ida.worker.v1.AnalysisTools.GetEntryPoint:output_type -> ida.worker.v1.GetEntryPointResponse
-	47,  // 86: ida.worker.v1.AnalysisTools.GetStrings:output_type -> ida.worker.v1.GetStringsResponse
-	49,  // 87: ida.worker.v1.AnalysisTools.MakeFunction:output_type -> ida.worker.v1.MakeFunctionResponse
-	51,  // 88: ida.worker.v1.AnalysisTools.ImportIl2Cpp:output_type -> ida.worker.v1.ImportIl2CppResponse
-	53,  // 89: ida.worker.v1.AnalysisTools.ImportFlutter:output_type -> ida.worker.v1.ImportFlutterResponse
-	78,  // 90: ida.worker.v1.AnalysisTools.GetGlobals:output_type -> ida.worker.v1.GetGlobalsResponse
-	80,  // 91: ida.worker.v1.AnalysisTools.SetGlobalType:output_type -> ida.worker.v1.SetGlobalTypeResponse
-	82,  // 92: ida.worker.v1.AnalysisTools.RenameGlobal:output_type -> ida.worker.v1.RenameGlobalResponse
-	84,  // 93: ida.worker.v1.AnalysisTools.DataReadString:output_type -> ida.worker.v1.DataReadStringResponse
-	86,  // 94: ida.worker.v1.AnalysisTools.DataReadByte:output_type -> ida.worker.v1.DataReadByteResponse
-	105, // 95: ida.worker.v1.AnalysisTools.FindBinary:output_type -> ida.worker.v1.FindBinaryResponse
-	107, // 96: ida.worker.v1.AnalysisTools.FindText:output_type -> ida.worker.v1.FindTextResponse
-	89,  // 97: ida.worker.v1.AnalysisTools.ListStructs:output_type -> ida.worker.v1.ListStructsResponse
-	92,  // 98: ida.worker.v1.AnalysisTools.GetStruct:output_type -> ida.worker.v1.GetStructResponse
-	95,  // 99: ida.worker.v1.AnalysisTools.ListEnums:output_type -> ida.worker.v1.ListEnumsResponse
-	98,  // 100: ida.worker.v1.AnalysisTools.GetEnum:output_type -> ida.worker.v1.GetEnumResponse
-	101, // 101: ida.worker.v1.AnalysisTools.GetFunctionInfo:output_type -> ida.worker.v1.GetFunctionInfoResponse
-	103, // 102: ida.worker.v1.AnalysisTools.GetTypeAt:output_type -> ida.worker.v1.GetTypeAtResponse
-	55,  // 103: ida.worker.v1.AnalysisTools.GetDwordAt:output_type -> ida.worker.v1.GetDwordAtResponse
-	57,  // 104: ida.worker.v1.AnalysisTools.GetQwordAt:output_type -> ida.worker.v1.GetQwordAtResponse
-	59,  // 105: ida.worker.v1.AnalysisTools.GetInstructionLength:output_type -> ida.worker.v1.GetInstructionLengthResponse
-	65,  // 106: ida.worker.v1.AnalysisTools.SetComment:output_type -> ida.worker.v1.SetCommentResponse
-	67,  // 107: ida.worker.v1.AnalysisTools.GetComment:output_type -> ida.worker.v1.GetCommentResponse
-	69,  // 108: ida.worker.v1.AnalysisTools.SetFuncComment:output_type -> ida.worker.v1.SetFuncCommentResponse
-	109, // 109: ida.worker.v1.AnalysisTools.GetFuncComment:output_type -> ida.worker.v1.GetFuncCommentResponse
-	71,  // 110: ida.worker.v1.AnalysisTools.SetLvarType:output_type -> ida.worker.v1.SetLvarTypeResponse
-	73,  // 111: ida.worker.v1.AnalysisTools.RenameLvar:output_type -> ida.worker.v1.RenameLvarResponse
-	75,  // 112: ida.worker.v1.AnalysisTools.SetDecompilerComment:output_type -> ida.worker.v1.SetDecompilerCommentResponse
-	111, // 113: ida.worker.v1.AnalysisTools.SetName:output_type -> ida.worker.v1.SetNameResponse
-	113, // 114: ida.worker.v1.AnalysisTools.GetName:output_type -> ida.worker.v1.GetNameResponse
-	115, // 115: ida.worker.v1.AnalysisTools.DeleteName:output_type -> ida.worker.v1.DeleteNameResponse
-	117, // 116: ida.worker.v1.AnalysisTools.SetFunctionType:output_type -> ida.worker.v1.SetFunctionTypeResponse
-	61,  // 117: ida.worker.v1.Healthcheck.Ping:output_type -> ida.worker.v1.PingResponse
-	63,  // 118: ida.worker.v1.Healthcheck.StatusStream:output_type -> ida.worker.v1.WorkerStatus
-	67,  // [67:119] is the sub-list for method output_type
-	15,  // [15:67] is the sub-list for method input_type
+	10,  // 20: ida.worker.v1.SessionControl.Cancel:input_type -> ida.worker.v1.CancelRequest
+	12,  // 21: ida.worker.v1.AnalysisTools.GetBytes:input_type -> ida.worker.v1.GetBytesRequest
+	14,  // 22: ida.worker.v1.AnalysisTools.GetDisasm:input_type -> ida.worker.v1.GetDisasmRequest
+	16,  // 23: ida.worker.v1.AnalysisTools.GetFunctionDisasm:input_type -> ida.worker.v1.GetFunctionDisasmRequest
+	18,  // 24: ida.worker.v1.AnalysisTools.GetDecompiled:input_type -> ida.worker.v1.GetDecompiledRequest
+	20,  // 25: ida.worker.v1.AnalysisTools.GetFunctionName:input_type -> ida.worker.v1.GetFunctionNameRequest
+	22,  // 26: ida.worker.v1.AnalysisTools.GetSegments:input_type -> ida.worker.v1.GetSegmentsRequest
+	25,  // 27: ida.worker.v1.AnalysisTools.GetFunctions:input_type -> ida.worker.v1.GetFunctionsRequest
+	28,  // 28: ida.worker.v1.AnalysisTools.GetXRefsTo:input_type -> ida.worker.v1.GetXRefsToRequest
+	31,  // 29: ida.worker.v1.AnalysisTools.GetXRefsFrom:input_type -> ida.worker.v1.GetXRefsFromRequest
+	33,  // 30: ida.worker.v1.AnalysisTools.GetDataRefs:input_type -> ida.worker.v1.GetDataRefsRequest
+	36,  // 31: ida.worker.v1.AnalysisTools.GetStringXRefs:input_type -> ida.worker.v1.GetStringXRefsRequest
+	39,  // 32: ida.worker.v1.AnalysisTools.GetImports:input_type -> ida.worker.v1.GetImportsRequest
+	42,  // 33: ida.worker.v1.AnalysisTools.GetExports:input_type -> ida.worker.v1.GetExportsRequest
+	45,  // 34: ida.worker.v1.AnalysisTools.GetEntryPoint:input_type -> ida.worker.v1.GetEntryPointRequest
+	47,  // 35: ida.worker.v1.AnalysisTools.GetStrings:input_type -> ida.worker.v1.GetStringsRequest
+	50,  // 36: ida.worker.v1.AnalysisTools.MakeFunction:input_type -> ida.worker.v1.MakeFunctionRequest
+	52,  // 37: ida.worker.v1.AnalysisTools.ImportIl2Cpp:input_type -> ida.worker.v1.ImportIl2CppRequest
+	54,  // 38: ida.worker.v1.AnalysisTools.ImportFlutter:input_type -> ida.worker.v1.ImportFlutterRequest
+	78,  // 39: ida.worker.v1.AnalysisTools.GetGlobals:input_type -> ida.worker.v1.GetGlobalsRequest
+	81,  // 40: ida.worker.v1.AnalysisTools.SetGlobalType:input_type -> ida.worker.v1.SetGlobalTypeRequest
+	83,  // 41: ida.worker.v1.AnalysisTools.RenameGlobal:input_type -> ida.worker.v1.RenameGlobalRequest
+	85,  // 42: ida.worker.v1.AnalysisTools.DataReadString:input_type -> ida.worker.v1.DataReadStringRequest
+	87,  // 43: ida.worker.v1.AnalysisTools.DataReadByte:input_type -> ida.worker.v1.DataReadByteRequest
+	106, // 44: ida.worker.v1.AnalysisTools.FindBinary:input_type -> ida.worker.v1.FindBinaryRequest
+	108, // 45: ida.worker.v1.AnalysisTools.FindText:input_type -> ida.worker.v1.FindTextRequest
+	89,  // 46: ida.worker.v1.AnalysisTools.ListStructs:input_type -> ida.worker.v1.ListStructsRequest
+	92,  // 47: ida.worker.v1.AnalysisTools.GetStruct:input_type -> ida.worker.v1.GetStructRequest
+	95,  // 48: ida.worker.v1.AnalysisTools.ListEnums:input_type -> ida.worker.v1.ListEnumsRequest
+	98,  // 49: ida.worker.v1.AnalysisTools.GetEnum:input_type -> ida.worker.v1.GetEnumRequest
+	101, // 50: ida.worker.v1.AnalysisTools.GetFunctionInfo:input_type -> ida.worker.v1.GetFunctionInfoRequest
+	104, // 51: ida.worker.v1.AnalysisTools.GetTypeAt:input_type -> ida.worker.v1.GetTypeAtRequest
+	56,  // 52: ida.worker.v1.AnalysisTools.GetDwordAt:input_type -> ida.worker.v1.GetDwordAtRequest
+	58,  // 53: ida.worker.v1.AnalysisTools.GetQwordAt:input_type -> ida.worker.v1.GetQwordAtRequest
+	60,  // 54: ida.worker.v1.AnalysisTools.GetInstructionLength:input_type -> ida.worker.v1.GetInstructionLengthRequest
+	66,  // 55: ida.worker.v1.AnalysisTools.SetComment:input_type -> ida.worker.v1.SetCommentRequest
+	68,  // 56: ida.worker.v1.AnalysisTools.GetComment:input_type -> ida.worker.v1.GetCommentRequest
+	70,  // 57: ida.worker.v1.AnalysisTools.SetFuncComment:input_type -> ida.worker.v1.SetFuncCommentRequest
+	110, // 58: ida.worker.v1.AnalysisTools.GetFuncComment:input_type -> ida.worker.v1.GetFuncCommentRequest
+	72,  // 59: ida.worker.v1.AnalysisTools.SetLvarType:input_type -> ida.worker.v1.SetLvarTypeRequest
+	74,  // 60: ida.worker.v1.AnalysisTools.RenameLvar:input_type -> ida.worker.v1.RenameLvarRequest
+	76,  // 61: ida.worker.v1.AnalysisTools.SetDecompilerComment:input_type -> ida.worker.v1.SetDecompilerCommentRequest
+	112, // 62: ida.worker.v1.AnalysisTools.SetName:input_type -> ida.worker.v1.SetNameRequest
+	114, // 63: ida.worker.v1.AnalysisTools.GetName:input_type -> ida.worker.v1.GetNameRequest
+	116, // 64: ida.worker.v1.AnalysisTools.DeleteName:input_type -> ida.worker.v1.DeleteNameRequest
+	118, // 65: ida.worker.v1.AnalysisTools.SetFunctionType:input_type -> ida.worker.v1.SetFunctionTypeRequest
+	62,  // 66: ida.worker.v1.Healthcheck.Ping:input_type -> ida.worker.v1.PingRequest
+	64,  // 67: ida.worker.v1.Healthcheck.StatusStream:input_type -> ida.worker.v1.StatusStreamRequest
+	1,   // 68: ida.worker.v1.SessionControl.OpenBinary:output_type -> ida.worker.v1.OpenBinaryResponse
+	3,   // 69: ida.worker.v1.SessionControl.CloseSession:output_type -> ida.worker.v1.CloseSessionResponse
+	5,   // 70: ida.worker.v1.SessionControl.SaveDatabase:output_type -> ida.worker.v1.SaveDatabaseResponse
+	7,   // 71: ida.worker.v1.SessionControl.PlanAndWait:output_type -> ida.worker.v1.PlanAndWaitResponse
+	9,   // 72: ida.worker.v1.SessionControl.GetSessionInfo:output_type -> ida.worker.v1.GetSessionInfoResponse
+	11,  // 73: ida.worker.v1.SessionControl.Cancel:output_type -> ida.worker.v1.CancelResponse
+	13,  // 74: ida.worker.v1.AnalysisTools.GetBytes:output_type -> ida.worker.v1.GetBytesResponse
+	15,  // 75: ida.worker.v1.AnalysisTools.GetDisasm:output_type -> ida.worker.v1.GetDisasmResponse
+	17,  // 76: ida.worker.v1.AnalysisTools.GetFunctionDisasm:output_type -> ida.worker.v1.GetFunctionDisasmResponse
+	19,  // 77: ida.worker.v1.AnalysisTools.GetDecompiled:output_type -> ida.worker.v1.GetDecompiledResponse
+	21,  // 78: ida.worker.v1.AnalysisTools.GetFunctionName:output_type -> ida.worker.v1.GetFunctionNameResponse
+	24,  // 79: ida.worker.v1.AnalysisTools.GetSegments:output_type -> ida.worker.v1.GetSegmentsResponse
+	27,  // 80: ida.worker.v1.AnalysisTools.GetFunctions:output_type -> ida.worker.v1.GetFunctionsResponse
+	30,  // 81: ida.worker.v1.AnalysisTools.GetXRefsTo:output_type -> ida.worker.v1.GetXRefsToResponse
+	32,  // 82: ida.worker.v1.AnalysisTools.GetXRefsFrom:output_type -> ida.worker.v1.GetXRefsFromResponse
+	35,  // 83: ida.worker.v1.AnalysisTools.GetDataRefs:output_type -> ida.worker.v1.GetDataRefsResponse
+	38,  // 84: ida.worker.v1.AnalysisTools.GetStringXRefs:output_type -> ida.worker.v1.GetStringXRefsResponse
+	41,  // 85: ida.worker.v1.AnalysisTools.GetImports:output_type -> ida.worker.v1.GetImportsResponse
+	44,  // 86: ida.worker.v1.AnalysisTools.GetExports:output_type -> ida.worker.v1.GetExportsResponse
+	46,  // 87: ida.worker.v1.AnalysisTools.GetEntryPoint:output_type -> ida.worker.v1.GetEntryPointResponse
+	49,  // 88: ida.worker.v1.AnalysisTools.GetStrings:output_type -> ida.worker.v1.GetStringsResponse
+	51,  // 89: ida.worker.v1.AnalysisTools.MakeFunction:output_type -> ida.worker.v1.MakeFunctionResponse
+	53,  // 90: ida.worker.v1.AnalysisTools.ImportIl2Cpp:output_type -> ida.worker.v1.ImportIl2CppResponse
+	55,  // 91: ida.worker.v1.AnalysisTools.ImportFlutter:output_type -> ida.worker.v1.ImportFlutterResponse
+	80,  // 92: ida.worker.v1.AnalysisTools.GetGlobals:output_type -> ida.worker.v1.GetGlobalsResponse
+	82,  // 93: ida.worker.v1.AnalysisTools.SetGlobalType:output_type -> ida.worker.v1.SetGlobalTypeResponse
+	84,  // 94: ida.worker.v1.AnalysisTools.RenameGlobal:output_type -> ida.worker.v1.RenameGlobalResponse
+	86,  // 95: ida.worker.v1.AnalysisTools.DataReadString:output_type -> ida.worker.v1.DataReadStringResponse
+	88,  // 96: ida.worker.v1.AnalysisTools.DataReadByte:output_type -> ida.worker.v1.DataReadByteResponse
+	107, // 97: ida.worker.v1.AnalysisTools.FindBinary:output_type -> ida.worker.v1.FindBinaryResponse
+	109, // 98: ida.worker.v1.AnalysisTools.FindText:output_type -> ida.worker.v1.FindTextResponse
+	91,  // 99: ida.worker.v1.AnalysisTools.ListStructs:output_type -> ida.worker.v1.ListStructsResponse
+	94,  // 100: ida.worker.v1.AnalysisTools.GetStruct:output_type -> ida.worker.v1.GetStructResponse
+	97,  // 101: ida.worker.v1.AnalysisTools.ListEnums:output_type -> ida.worker.v1.ListEnumsResponse
+	100, // 102: ida.worker.v1.AnalysisTools.GetEnum:output_type -> ida.worker.v1.GetEnumResponse
+	103, // 103: ida.worker.v1.AnalysisTools.GetFunctionInfo:output_type -> ida.worker.v1.GetFunctionInfoResponse
+	105, // 104: ida.worker.v1.AnalysisTools.GetTypeAt:output_type -> ida.worker.v1.GetTypeAtResponse
+	57,  // 105: ida.worker.v1.AnalysisTools.GetDwordAt:output_type -> ida.worker.v1.GetDwordAtResponse
+	59,  // 106: ida.worker.v1.AnalysisTools.GetQwordAt:output_type -> ida.worker.v1.GetQwordAtResponse
+	61,  // 107: ida.worker.v1.AnalysisTools.GetInstructionLength:output_type -> ida.worker.v1.GetInstructionLengthResponse
+	67,  // 108: ida.worker.v1.AnalysisTools.SetComment:output_type -> ida.worker.v1.SetCommentResponse
+	69,  // 109: ida.worker.v1.AnalysisTools.GetComment:output_type -> ida.worker.v1.GetCommentResponse
+	71,  // 110: ida.worker.v1.AnalysisTools.SetFuncComment:output_type -> ida.worker.v1.SetFuncCommentResponse
+	111, // 111: ida.worker.v1.AnalysisTools.GetFuncComment:output_type -> ida.worker.v1.GetFuncCommentResponse
+	73,  // 112: ida.worker.v1.AnalysisTools.SetLvarType:output_type -> ida.worker.v1.SetLvarTypeResponse
+	75,  // 113: ida.worker.v1.AnalysisTools.RenameLvar:output_type -> ida.worker.v1.RenameLvarResponse
+	77,  // 114: ida.worker.v1.AnalysisTools.SetDecompilerComment:output_type -> ida.worker.v1.SetDecompilerCommentResponse
+	113, // 115: ida.worker.v1.AnalysisTools.SetName:output_type -> ida.worker.v1.SetNameResponse
+	115, // 116: ida.worker.v1.AnalysisTools.GetName:output_type -> ida.worker.v1.GetNameResponse
+	117, // 117: ida.worker.v1.AnalysisTools.DeleteName:output_type -> ida.worker.v1.DeleteNameResponse
+	119, // 118: ida.worker.v1.AnalysisTools.SetFunctionType:output_type -> ida.worker.v1.SetFunctionTypeResponse
+	63,  // 119: ida.worker.v1.Healthcheck.Ping:output_type -> ida.worker.v1.PingResponse
+	65,  // 120: ida.worker.v1.Healthcheck.StatusStream:output_type -> ida.worker.v1.WorkerStatus
+	68,  // [68:121] is the sub-list for method output_type
+	15,  // [15:68] is the sub-list for method input_type
 	15,  // [15:15] is the sub-list for extension type_name
 	15,  // [15:15] is the sub-list for extension extendee
 	0,   // [0:15] is the sub-list for field type_name
@@ -7251,7 +7397,7 @@ func file_ida_worker_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ida_worker_v1_service_proto_rawDesc), len(file_ida_worker_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   118,
+			NumMessages:   120,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
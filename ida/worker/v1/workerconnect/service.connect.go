@@ -52,6 +52,8 @@ const (
 	// SessionControlGetSessionInfoProcedure is the fully-qualified name of the SessionControl's
 	// GetSessionInfo RPC.
 	SessionControlGetSessionInfoProcedure = "/ida.worker.v1.SessionControl/GetSessionInfo"
+	// SessionControlCancelProcedure is the fully-qualified name of the SessionControl's Cancel RPC.
+	SessionControlCancelProcedure = "/ida.worker.v1.SessionControl/Cancel"
 	// AnalysisToolsGetBytesProcedure is the fully-qualified name of the AnalysisTools's GetBytes RPC.
 	AnalysisToolsGetBytesProcedure = "/ida.worker.v1.AnalysisTools/GetBytes"
 	// AnalysisToolsGetDisasmProcedure is the fully-qualified name of the AnalysisTools's GetDisasm RPC.
@@ -197,6 +199,8 @@ type SessionControlClient interface {
 	PlanAndWait(context.Context, *connect.Request[v1.PlanAndWaitRequest]) (*connect.Response[v1.PlanAndWaitResponse], error)
 	// GetSessionInfo returns current session metadata
 	GetSessionInfo(context.Context, *connect.Request[v1.GetSessionInfoRequest]) (*connect.Response[v1.GetSessionInfoResponse], error)
+	// Cancel aborts a running or queued call at its next safe point
+	Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error)
 }
 
 // NewSessionControlClient constructs a client for the ida.worker.v1.SessionControl service. By
@@ -240,6 +244,12 @@ func NewSessionControlClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sessionControlMethods.ByName("GetSessionInfo")),
 			connect.WithClientOptions(opts...),
 		),
+		cancel: connect.NewClient[v1.CancelRequest, v1.CancelResponse](
+			httpClient,
+			baseURL+SessionControlCancelProcedure,
+			connect.WithSchema(sessionControlMethods.ByName("Cancel")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -250,6 +260,7 @@ type sessionControlClient struct {
 	saveDatabase   *connect.Client[v1.SaveDatabaseRequest, v1.SaveDatabaseResponse]
 	planAndWait    *connect.Client[v1.PlanAndWaitRequest, v1.PlanAndWaitResponse]
 	getSessionInfo *connect.Client[v1.GetSessionInfoRequest, v1.GetSessionInfoResponse]
+	cancel         *connect.Client[v1.CancelRequest, v1.CancelResponse]
 }
 
 // OpenBinary calls ida.worker.v1.SessionControl.OpenBinary.
@@ -277,6 +288,11 @@ func (c *sessionControlClient) GetSessionInfo(ctx context.Context, req *connect.
 	return c.getSessionInfo.CallUnary(ctx, req)
 }
 
+// Cancel calls ida.worker.v1.SessionControl.Cancel.
+func (c *sessionControlClient) Cancel(ctx context.Context, req *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error) {
+	return c.cancel.CallUnary(ctx, req)
+}
+
 // SessionControlHandler is an implementation of the ida.worker.v1.SessionControl service.
 type SessionControlHandler interface {
 	// OpenBinary opens a binary file for analysis
@@ -289,6 +305,8 @@ type SessionControlHandler interface {
 	PlanAndWait(context.Context, *connect.Request[v1.PlanAndWaitRequest]) (*connect.Response[v1.PlanAndWaitResponse], error)
 	// GetSessionInfo returns current session metadata
 	GetSessionInfo(context.Context, *connect.Request[v1.GetSessionInfoRequest]) (*connect.Response[v1.GetSessionInfoResponse], error)
+	// Cancel aborts a running or queued call at its next safe point
+	Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error)
 }
 
 // NewSessionControlHandler builds an HTTP handler from the service implementation. It returns the
@@ -328,6 +346,12 @@ func NewSessionControlHandler(svc SessionControlHandler, opts ...connect.Handler
 		connect.WithSchema(sessionControlMethods.ByName("GetSessionInfo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sessionControlCancelHandler := connect.NewUnaryHandler(
+		SessionControlCancelProcedure,
+		svc.Cancel,
+		connect.WithSchema(sessionControlMethods.ByName("Cancel")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ida.worker.v1.SessionControl/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SessionControlOpenBinaryProcedure:
@@ -340,6 +364,8 @@ func NewSessionControlHandler(svc SessionControlHandler, opts ...connect.Handler
 			sessionControlPlanAndWaitHandler.ServeHTTP(w, r)
 		case SessionControlGetSessionInfoProcedure:
 			sessionControlGetSessionInfoHandler.ServeHTTP(w, r)
+		case SessionControlCancelProcedure:
+			sessionControlCancelHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -369,6 +395,10 @@ func (UnimplementedSessionControlHandler) GetSessionInfo(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ida.worker.v1.SessionControl.GetSessionInfo is not implemented"))
 }
 
+func (UnimplementedSessionControlHandler) Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ida.worker.v1.SessionControl.Cancel is not implemented"))
+}
+
 // AnalysisToolsClient is a client for the ida.worker.v1.AnalysisTools service.
 type AnalysisToolsClient interface {
 	// GetBytes reads raw bytes from address
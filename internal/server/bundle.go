@@ -0,0 +1,372 @@
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// bundleFormatVersion is written to every exported bundle. Imports refuse
+// bundles from a newer format.
+const bundleFormatVersion = 1
+
+// A session bundle is a gzipped tar holding manifest.json and the saved
+// database under database/.
+const (
+	bundleManifestName = "manifest.json"
+	bundleDatabaseDir  = "database/"
+	bundleExt          = ".idabundle.tar.gz"
+)
+
+// maxImportBytes bounds what an import may extract when no storage quota
+// applies.
+const maxImportBytes = 64 << 30
+
+// maxManifestBytes bounds manifest.json.
+const maxManifestBytes = 1 << 20
+
+// errBundleTooLarge reports a bundle that would extract past its limit.
+var errBundleTooLarge = errors.New("bundle too large")
+
+// bundleManifest describes an exported session.
+type bundleManifest struct {
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	// SHA-256 of the binary the database was built from
+	SHA256 string `json:"sha256"`
+	// File name of the database under database/
+	Database string           `json:"database"`
+	Session  session.Metadata `json:"session"`
+}
+
+// prepareExport saves an active session and returns its database. A dormant
+// session's database is already saved on disk.
+func (s *Server) prepareExport(ctx context.Context, op string, sess *session.Session) (string, *ToolError) {
+	if !sess.Dormant() {
+		if _, err := s.saveSession(ctx, sess); err != nil {
+			return "", idaOperationFailed(op, sess.ID, fmt.Errorf("save database: %w", err))
+		}
+	}
+	dbPath, err := sessionDatabase(sess)
+	if err != nil {
+		return "", idaOperationFailed(op, sess.ID, err)
+	}
+	return dbPath, nil
+}
+
+// writeBundle writes the session bundle for the database at dbPath to w.
+func writeBundle(w io.Writer, sess *session.Session, dbPath string) (bundleManifest, error) {
+	meta := sess.Metadata()
+	// Snapshots, forks, pins and placement only make sense on this server
+	meta.Snapshots = nil
+	meta.ParentID = ""
+	meta.Pinned = false
+	meta.DatabasePath = ""
+	manifest := bundleManifest{
+		FormatVersion: bundleFormatVersion,
+		ExportedAt:    time.Now().UTC(),
+		SHA256:        meta.SHA256,
+		Database:      filepath.Base(dbPath),
+		Session:       meta,
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+
+	db, err := os.Open(dbPath)
+	if err != nil {
+		return manifest, err
+	}
+	defer db.Close()
+	info, err := db.Stat()
+	if err != nil {
+		return manifest, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	err = tw.WriteHeader(&tar.Header{
+		Name:    bundleManifestName,
+		Mode:    0o644,
+		Size:    int64(len(manifestData)),
+		ModTime: manifest.ExportedAt,
+	})
+	if err == nil {
+		_, err = tw.Write(manifestData)
+	}
+	if err == nil {
+		err = tw.WriteHeader(&tar.Header{
+			Name:    bundleDatabaseDir + manifest.Database,
+			Mode:    0o644,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+	if err == nil {
+		_, err = io.Copy(tw, db)
+	}
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gz.Close()
+	}
+	return manifest, err
+}
+
+// readBundle unpacks a session bundle into dir and returns its manifest and
+// the path of the extracted database, which may be at most limit bytes.
+func readBundle(r io.Reader, dir string, limit int64) (bundleManifest, string, error) {
+	var manifest bundleManifest
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return manifest, "", err
+	}
+	defer gz.Close()
+
+	var haveManifest bool
+	var dbPath string
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return manifest, "", err
+		}
+		switch {
+		case hdr.Name == bundleManifestName:
+			if haveManifest || hdr.Size > maxManifestBytes {
+				return manifest, "", fmt.Errorf("unexpected manifest entry of %d bytes", hdr.Size)
+			}
+			if err := json.NewDecoder(io.LimitReader(tr, maxManifestBytes)).Decode(&manifest); err != nil {
+				return manifest, "", fmt.Errorf("decode manifest: %w", err)
+			}
+			haveManifest = true
+		case strings.HasPrefix(hdr.Name, bundleDatabaseDir):
+			name := strings.TrimPrefix(hdr.Name, bundleDatabaseDir)
+			if dbPath != "" || hdr.Typeflag != tar.TypeReg || name != path.Base(name) || !isDatabaseFile(name) {
+				return manifest, "", fmt.Errorf("unexpected database entry %q", hdr.Name)
+			}
+			if hdr.Size > limit {
+				return manifest, "", fmt.Errorf("%w: database is %d bytes, %d allowed", errBundleTooLarge, hdr.Size, limit)
+			}
+			dbPath = filepath.Join(dir, name)
+			out, err := os.Create(dbPath)
+			if err != nil {
+				return manifest, "", err
+			}
+			n, err := io.Copy(out, io.LimitReader(tr, limit+1))
+			if closeErr := out.Close(); err == nil {
+				err = closeErr
+			}
+			if err == nil && n > limit {
+				err = fmt.Errorf("%w: database exceeds %d bytes", errBundleTooLarge, limit)
+			}
+			if err != nil {
+				return manifest, "", fmt.Errorf("extract database: %w", err)
+			}
+		default:
+			return manifest, "", fmt.Errorf("unexpected entry %q", hdr.Name)
+		}
+	}
+
+	switch {
+	case !haveManifest:
+		return manifest, "", errors.New("missing " + bundleManifestName)
+	case manifest.FormatVersion < 1 || manifest.FormatVersion > bundleFormatVersion:
+		return manifest, "", fmt.Errorf("unsupported bundle format version %d (this server reads up to %d)", manifest.FormatVersion, bundleFormatVersion)
+	case dbPath == "" || filepath.Base(dbPath) != manifest.Database:
+		return manifest, "", fmt.Errorf("bundle does not contain database %q", manifest.Database)
+	}
+	return manifest, dbPath, nil
+}
+
+// importLimit is how large a database an import may extract: what is left
+// of database_quota_mb, and no more than session_quota_mb.
+func (s *Server) importLimit() int64 {
+	limit := int64(maxImportBytes)
+	if s.databaseQuota > 0 {
+		used, _ := storageUsage(s.storageEntries())
+		limit = min(limit, s.databaseQuota-used)
+	}
+	if s.sessionQuota > 0 {
+		limit = min(limit, s.sessionQuota)
+	}
+	return max(limit, 0)
+}
+
+// maxBundleBody bounds an uploaded bundle holding a database of at most
+// limit bytes: gzip's stored blocks add well under 0.1%, and the manifest
+// and tar headers fit in the rest.
+func maxBundleBody(limit int64) int64 {
+	return limit + limit/1024 + 2*maxManifestBytes
+}
+
+// importBundle unpacks a bundle into the database directory and opens the
+// database as a new session.
+func (s *Server) importBundle(ctx context.Context, op string, r io.Reader) (*session.Session, bundleManifest, *ToolError) {
+	if toolErr := s.checkStorageQuota(op); toolErr != nil {
+		return nil, bundleManifest{}, toolErr
+	}
+	limit := s.importLimit()
+	importsDir := filepath.Join(s.databaseDir, storageImports)
+	if err := os.MkdirAll(importsDir, 0o755); err != nil {
+		return nil, bundleManifest{}, internalError(op, err)
+	}
+	dir, err := os.MkdirTemp(importsDir, "import-")
+	if err != nil {
+		return nil, bundleManifest{}, internalError(op, err)
+	}
+	manifest, dbPath, err := readBundle(r, dir, limit)
+	if err != nil {
+		os.RemoveAll(dir)
+		var bodyErr *http.MaxBytesError
+		if errors.Is(err, errBundleTooLarge) || errors.As(err, &bodyErr) {
+			return nil, manifest, bundleTooLarge(op, limit, err)
+		}
+		return nil, manifest, invalidInput(op, fmt.Sprintf("invalid session bundle: %v", err))
+	}
+
+	var sess *session.Session
+	err = s.withSessionSlot(func() (err error) {
+		sess, err = s.registry.OpenNew(dbPath, session.FileIdentity{SHA256: manifest.SHA256}, s.sessionTimeout)
+		return err
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		if errors.Is(err, session.ErrMaxSessions) {
+			return nil, manifest, sessionLimitReached(op, err)
+		}
+		return nil, manifest, internalError(op, err)
+	}
+	defer sess.Begin()()
+	sess.SetLabels(session.Labels{
+		Label: manifest.Session.Label,
+		Owner: manifest.Session.Owner,
+		Tags:  manifest.Session.Tags,
+	})
+	if toolErr := s.startOwnedSession(ctx, op, sess); toolErr != nil {
+		return nil, manifest, toolErr
+	}
+	s.persistSession(sess)
+	s.registry.FinishOpen(sess.ID, nil)
+	s.logger.Printf("[Import] Session %s imported from session %s (sha256 %s) at %s", sess.ID, manifest.Session.ID, manifest.SHA256, dbPath)
+	return sess, manifest, nil
+}
+
+func importResult(sess *session.Session, manifest bundleManifest) map[string]any {
+	result := map[string]any{
+		"session_id":        sess.ID,
+		"binary_path":       sess.BinaryPath,
+		"sha256":            manifest.SHA256,
+		"source_session_id": manifest.Session.ID,
+		"source_binary":     manifest.Session.BinaryPath,
+		"exported_at":       manifest.ExportedAt.Unix(),
+		"format_version":    manifest.FormatVersion,
+	}
+	labelsJSON(result, sess.Labels())
+	return result
+}
+
+func (s *Server) exportSession(ctx context.Context, req *mcp.CallToolRequest, args ExportSessionRequest) (*mcp.CallToolResult, any, error) {
+	const op = "export_session"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"output_path": args.OutputPath})
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	dbPath, toolErr := s.prepareExport(ctx, op, sess)
+	if toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	output := args.OutputPath
+	if output == "" {
+		output = filepath.Join(s.databaseDir, "exports", sess.ID+bundleExt)
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return s.handleToolError(internalError(op, err))
+	}
+
+	// Written next to the target and renamed, so a partial bundle never
+	// carries the final name
+	tmp := output + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return s.handleToolError(internalError(op, err))
+	}
+	manifest, err := writeBundle(f, sess, dbPath)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, output)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return s.handleToolError(internalError(op, fmt.Errorf("write bundle: %w", err)))
+	}
+	info, err := os.Stat(output)
+	if err != nil {
+		return s.handleToolError(internalError(op, err))
+	}
+	s.logger.Printf("[Export] Session %s exported to %s (%d bytes)", sess.ID, output, info.Size())
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id":     sess.ID,
+		"bundle_path":    output,
+		"size":           info.Size(),
+		"sha256":         manifest.SHA256,
+		"database":       manifest.Database,
+		"format_version": manifest.FormatVersion,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) importSession(ctx context.Context, req *mcp.CallToolRequest, args ImportSessionRequest) (*mcp.CallToolResult, any, error) {
+	const op = "import_session"
+	s.logToolInvocation(op, "", map[string]interface{}{"bundle_path": args.BundlePath})
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	f, err := os.Open(args.BundlePath)
+	if err != nil {
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("cannot read bundle: %v", err)))
+	}
+	defer f.Close()
+
+	sess, manifest, toolErr := s.importBundle(ctx, op, f)
+	if toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	jsonResult, _ := s.marshalJSON(importResult(sess, manifest))
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+// cancelWait bounds how long a cancelled call waits for the worker to reach
+// a safe point and stop.
+const cancelWait = 5 * time.Second
+
+// cancelOutcome is what the worker reported for a cancelled call.
+type cancelOutcome struct {
+	found   bool
+	stopped bool
+}
+
+// propagateCancel passes the cancellation of a tool call on to its worker.
+// Abandoning the RPC leaves the worker running it, so every worker call made
+// for the tool carries a request ID, and SessionControl.Cancel asks the
+// worker to stop that call at its next safe point.
+func (s *Server) propagateCancel(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		tool, sess := s.callSession(method, req)
+		if sess == nil {
+			return next(ctx, method, req)
+		}
+		requestID := uuid.NewString()
+		ctx = worker.WithRequestID(ctx, requestID)
+		outcome := make(chan cancelOutcome, 1)
+		stop := context.AfterFunc(ctx, func() {
+			outcome <- s.cancelWorkerCall(sess.ID, requestID)
+		})
+
+		result, err := next(ctx, method, req)
+		if stop() {
+			return result, err
+		}
+		cancelled := <-outcome
+		if res, ok := result.(*mcp.CallToolResult); ok && err == nil && !res.IsError {
+			// Finished before the worker saw the cancellation
+			return result, err
+		}
+		if cancelled.found {
+			sess.MarkDirty()
+		}
+		s.logger.Printf("[Cancel] %s on session %s cancelled (worker stopped: %t)", tool, sess.ID, cancelled.stopped)
+		res, _, _ := s.handleToolError(callCancelled(tool, sess.ID, cancelled.stopped))
+		return res, nil
+	}
+}
+
+// cancelWorkerCall asks a session's worker to stop the call tagged with
+// requestID and waits up to cancelWait for it to do so.
+func (s *Server) cancelWorkerCall(sessionID, requestID string) cancelOutcome {
+	if requestID == "" {
+		return cancelOutcome{}
+	}
+	client, err := s.workers.GetClient(sessionID)
+	if err != nil {
+		return cancelOutcome{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cancelWait+time.Second)
+	defer cancel()
+	resp, err := (*client.SessionCtrl).Cancel(ctx, connect.NewRequest(&pb.CancelRequest{
+		RequestId: requestID,
+		WaitMs:    uint32(cancelWait.Milliseconds()),
+	}))
+	if err != nil {
+		s.logger.Printf("[Cancel] Failed to cancel call %s on session %s: %v", requestID, sessionID, err)
+		return cancelOutcome{}
+	}
+	return cancelOutcome{found: resp.Msg.Found, stopped: resp.Msg.Stopped}
+}
@@ -1,6 +1,13 @@
 package server
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
 
 // ErrorKind categorises errors by what the caller CAN DO, not by origin.
 // Follows the "Stop Forwarding Errors, Start Designing Them" philosophy.
@@ -12,6 +19,9 @@ const (
 	ErrIDAOperation          ErrorKind = "ida_operation_failed"
 	ErrInvalidInput          ErrorKind = "invalid_input"
 	ErrDecompilerUnavailable ErrorKind = "decompiler_unavailable"
+	ErrResourceLimit         ErrorKind = "resource_limit_exceeded"
+	ErrTimeout               ErrorKind = "timeout"
+	ErrCancelled             ErrorKind = "cancelled"
 	ErrInternal              ErrorKind = "internal"
 )
 
@@ -49,7 +59,7 @@ func sessionNotFound(operation, sessionID string) *ToolError {
 }
 
 func workerUnavailable(operation, sessionID string, err error) *ToolError {
-	return &ToolError{
+	te := &ToolError{
 		Kind:      ErrWorkerUnavailable,
 		Status:    StatusTemporary,
 		Message:   "worker process not available",
@@ -59,6 +69,141 @@ func workerUnavailable(operation, sessionID string, err error) *ToolError {
 			"detail":     err.Error(),
 		},
 	}
+	switch {
+	case errors.Is(err, session.ErrMaxSessions):
+		return sessionLimitReached(operation, err)
+	case errors.Is(err, worker.ErrResourceLimit):
+		return resourceLimitExceeded(operation, sessionID, err)
+	case errors.Is(err, worker.ErrInsufficientMemory):
+		te.Message = "host memory below the admission threshold; retry later"
+	case errors.Is(err, worker.ErrNoWorkerHost):
+		te.Message = "no worker host can take another session; retry later"
+	case errors.Is(err, worker.ErrWorkerRecovering):
+		te.Message = "worker crashed and is being restarted; retry shortly"
+	case errors.Is(err, worker.ErrCircuitOpen):
+		// Retrying will not help; the session must be closed and reopened
+		te.Status = StatusPermanent
+		te.Message = "worker crashed repeatedly; close and reopen the session"
+	}
+	return te
+}
+
+func resourceLimitExceeded(operation, sessionID string, err error) *ToolError {
+	return &ToolError{
+		Kind:      ErrResourceLimit,
+		Status:    StatusPermanent,
+		Message:   "worker was killed for exceeding its resource limit; close the session or raise worker_memory_limit_mb",
+		Operation: operation,
+		Context: map[string]any{
+			"session_id": sessionID,
+			"detail":     err.Error(),
+		},
+	}
+}
+
+func sessionLimitReached(operation string, err error) *ToolError {
+	te := &ToolError{
+		Kind:      ErrResourceLimit,
+		Status:    StatusPermanent,
+		Message:   "max_concurrent_sessions reached; close a session or set session_eviction to evict_lru",
+		Operation: operation,
+		Context:   map[string]any{"detail": err.Error()},
+	}
+	if errors.Is(err, session.ErrNoIdleSession) {
+		te.Status = StatusTemporary
+		te.Message = "max_concurrent_sessions reached and every session is busy or pinned; retry later"
+	}
+	return te
+}
+
+// storageQuotaExceeded reports database storage over its quota. sessionID
+// is empty for the total quota.
+func storageQuotaExceeded(operation, sessionID string, used, quota int64) *ToolError {
+	te := &ToolError{
+		Kind:      ErrResourceLimit,
+		Status:    StatusPermanent,
+		Message:   "database storage is over database_quota_mb; close sessions to free space",
+		Operation: operation,
+		Context: map[string]any{
+			"used_bytes":  used,
+			"quota_bytes": quota,
+		},
+	}
+	if sessionID != "" {
+		te.Message = "the session's database and snapshots are over session_quota_mb"
+		te.Context["session_id"] = sessionID
+	}
+	return te
+}
+
+// bundleTooLarge reports a session bundle larger than an import may extract.
+func bundleTooLarge(operation string, limit int64, err error) *ToolError {
+	return &ToolError{
+		Kind:      ErrResourceLimit,
+		Status:    StatusPermanent,
+		Message:   "the session bundle does not fit in the storage left under database_quota_mb and session_quota_mb",
+		Operation: operation,
+		Context: map[string]any{
+			"limit_bytes": limit,
+			"detail":      err.Error(),
+		},
+	}
+}
+
+func staleBinary(operation string, sess *session.Session, current session.FileIdentity) *ToolError {
+	return &ToolError{
+		Kind:      ErrInvalidInput,
+		Status:    StatusPermanent,
+		Message:   fmt.Sprintf("%s changed on disk since its database was built; close the session and open the binary again", sess.BinaryPath),
+		Operation: operation,
+		Context: map[string]any{
+			"session_id":      sess.ID,
+			"recorded_sha256": sess.Identity.SHA256,
+			"current_sha256":  current.SHA256,
+		},
+	}
+}
+
+// toolTimedOut reports a call that ran past its deadline. restarted is set
+// when the session's worker, still busy with the call, was killed and is
+// coming back from its last save.
+func toolTimedOut(operation, sessionID string, timeout time.Duration, restarted bool) *ToolError {
+	te := &ToolError{
+		Kind:      ErrTimeout,
+		Status:    StatusTemporary,
+		Message:   fmt.Sprintf("%s did not finish within %s; retry with a narrower request or raise tool_timeouts.%s", operation, timeout, operation),
+		Operation: operation,
+		Context: map[string]any{
+			"timeout_seconds": timeout.Seconds(),
+		},
+	}
+	if sessionID != "" {
+		te.Context["session_id"] = sessionID
+	}
+	if restarted {
+		te.Message = fmt.Sprintf("%s did not finish within %s and the worker was restarted from its last save, losing unsaved changes; retry once get_worker_status shows it running, with a narrower request or a higher tool_timeouts.%s", operation, timeout, operation)
+		te.Context["worker_restarted"] = true
+		te.Context["retry_after_seconds"] = timeoutRetryAfter.Seconds()
+	}
+	return te
+}
+
+func callCancelled(operation, sessionID string, stopped bool) *ToolError {
+	te := &ToolError{
+		Kind:      ErrCancelled,
+		Status:    StatusTemporary,
+		Message:   fmt.Sprintf("%s was cancelled before it finished; the database may be partially updated, so check the result before retrying", operation),
+		Operation: operation,
+		Context: map[string]any{
+			"session_id":     sessionID,
+			"worker_stopped": stopped,
+			"partial":        true,
+		},
+	}
+	if !stopped {
+		te.Message = fmt.Sprintf("%s was cancelled but the worker has not stopped yet and later calls on the session wait for it; the database may be partially updated", operation)
+	}
+	return te
 }
 
 func idaOperationFailed(operation, sessionID string, err error) *ToolError {
@@ -37,6 +37,10 @@ func (s *Server) importFlutter(ctx context.Context, req *mcp.CallToolRequest, ar
 	if msgErr := resp.Msg.GetError(); msgErr != "" && !resp.Msg.GetSuccess() {
 		return nil, s.logAndSanitizeError("import_flutter IDA operation", errors.New(msgErr)), nil
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, "import_flutter", 0, args, nil, "")
+	}
 	result := map[string]any{
 		"success":           resp.Msg.GetSuccess(),
 		"duration_seconds":  resp.Msg.GetDurationSeconds(),
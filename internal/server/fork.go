@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+// Annotation kinds merge_session_annotations can carry back to a parent
+const (
+	annotationNames    = "names"
+	annotationComments = "comments"
+	annotationTypes    = "types"
+)
+
+var annotationKinds = []string{annotationNames, annotationComments, annotationTypes}
+
+// ownedDir returns the directory the server created for a forked or
+// imported session's files. Sessions opened on a user's binary have none.
+func (s *Server) ownedDir(sess *session.Session) string {
+	if s.databaseDir == "" {
+		return ""
+	}
+	dir := filepath.Dir(sess.BinaryPath)
+	switch filepath.Dir(dir) {
+	case filepath.Join(s.databaseDir, storageForks), filepath.Join(s.databaseDir, storageImports):
+		return dir
+	}
+	return ""
+}
+
+func (s *Server) deleteOwnedFiles(sess *session.Session) {
+	dir := s.ownedDir(sess)
+	if dir == "" {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		s.logger.Printf("Warning: failed to delete files of session %s: %v", sess.ID, err)
+	}
+}
+
+// linkOrCopy hard links src to dst, copying it when the link fails.
+func linkOrCopy(src, dst string) error {
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+	_, err := copyFile(src, dst)
+	return err
+}
+
+// startOwnedSession starts the worker of a session opened on files the
+// server placed itself and opens its database. On failure the session and
+// its files are removed.
+func (s *Server) startOwnedSession(ctx context.Context, op string, sess *session.Session) *ToolError {
+	fail := func(toolErr *ToolError) *ToolError {
+		s.abortOpen(sess, toolErr)
+		s.deleteOwnedFiles(sess)
+		return toolErr
+	}
+	if err := s.workers.Start(ctx, sess, sess.BinaryPath); err != nil {
+		return fail(workerUnavailable(op, sess.ID, err))
+	}
+	client, err := s.workers.GetClient(sess.ID)
+	if err != nil {
+		s.workers.Stop(sess.ID)
+		return fail(workerUnavailable(op, sess.ID, err))
+	}
+	resp, err := (*client.SessionCtrl).OpenBinary(ctx, connect.NewRequest(&pb.OpenBinaryRequest{
+		BinaryPath:   sess.BinaryPath,
+		DatabasePath: sess.DatabasePath,
+		AutoAnalyze:  false,
+	}))
+	if err == nil && !resp.Msg.Success {
+		err = errors.New(resp.Msg.Error)
+	}
+	if err != nil {
+		s.workers.Stop(sess.ID)
+		return fail(idaOperationFailed(op, sess.ID, err))
+	}
+	sess.SetDatabaseInfo(databaseInfo(resp.Msg))
+	return nil
+}
+
+// copyForkFiles copies the parent's binary and database into a new fork
+// directory and returns the paths of the copies. The database keeps the
+// parent's file name, so the fork's worker is told where it is rather than
+// left to find it next to the binary.
+func (s *Server) copyForkFiles(parent *session.Session) (binaryPath, dbCopy string, err error) {
+	dbPath, err := sessionDatabase(parent)
+	if err != nil {
+		return "", "", err
+	}
+	forksDir := filepath.Join(s.databaseDir, storageForks)
+	if err := os.MkdirAll(forksDir, 0o755); err != nil {
+		return "", "", err
+	}
+	dir, err := os.MkdirTemp(forksDir, parent.ID+"-")
+	if err != nil {
+		return "", "", err
+	}
+	binaryPath = filepath.Join(dir, filepath.Base(parent.BinaryPath))
+	if err := linkOrCopy(parent.BinaryPath, binaryPath); err != nil {
+		os.RemoveAll(dir)
+		return "", "", fmt.Errorf("copy binary: %w", err)
+	}
+	dbCopy = filepath.Join(dir, filepath.Base(dbPath))
+	if _, err := copyFile(dbPath, dbCopy); err != nil {
+		os.RemoveAll(dir)
+		return "", "", fmt.Errorf("copy database: %w", err)
+	}
+	return binaryPath, dbCopy, nil
+}
+
+func (s *Server) forkSession(ctx context.Context, req *mcp.CallToolRequest, args ForkSessionRequest) (*mcp.CallToolResult, any, error) {
+	const op = "fork_session"
+	s.logToolInvocation(op, args.SessionID, nil)
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	parent, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	parent.Touch()
+	if toolErr := s.checkStorageQuota(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+
+	// A dormant parent's database is already saved on disk
+	if !parent.Dormant() {
+		if _, err := s.saveSession(ctx, parent); err != nil {
+			return s.handleToolError(idaOperationFailed(op, parent.ID, fmt.Errorf("save database: %w", err)))
+		}
+	}
+	binaryPath, dbPath, err := s.copyForkFiles(parent)
+	if err != nil {
+		return s.handleToolError(idaOperationFailed(op, parent.ID, err))
+	}
+
+	var fork *session.Session
+	err = s.withSessionSlot(func() (err error) {
+		fork, err = s.registry.OpenFork(parent, binaryPath, dbPath, s.sessionTimeout)
+		return err
+	})
+	if err != nil {
+		os.RemoveAll(filepath.Dir(binaryPath))
+		if errors.Is(err, session.ErrMaxSessions) {
+			return s.handleToolError(sessionLimitReached(op, err))
+		}
+		return s.handleToolError(internalError(op, err))
+	}
+	defer fork.Begin()()
+	// The fork belongs to whoever owns the parent
+	fork.SetLabels(parent.Labels())
+	if toolErr := s.startOwnedSession(ctx, op, fork); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+
+	s.persistSession(fork)
+	s.registry.FinishOpen(fork.ID, nil)
+	s.logger.Printf("[Fork] Session %s forked from %s at %s", fork.ID, parent.ID, binaryPath)
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id":         fork.ID,
+		"parent_id":          parent.ID,
+		"binary_path":        binaryPath,
+		"source_binary_path": parent.BinaryPath,
+		"created_at":         fork.CreatedAt.Unix(),
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+// annotationChange is one annotation copied to the parent.
+type annotationChange struct {
+	Address  uint64 `json:"address"`
+	Kind     string `json:"kind"`
+	Value    string `json:"value"`
+	Previous string `json:"previous,omitempty"`
+}
+
+func (s *Server) mergeSessionAnnotations(ctx context.Context, req *mcp.CallToolRequest, args MergeSessionAnnotationsRequest) (*mcp.CallToolResult, any, error) {
+	const op = "merge_session_annotations"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"addresses": len(args.Addresses), "kinds": args.Kinds})
+	if len(args.Addresses) == 0 {
+		return s.handleToolError(invalidInput(op, "addresses is required"))
+	}
+	kinds := args.Kinds
+	if len(kinds) == 0 {
+		kinds = annotationKinds
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(annotationKinds, kind) {
+			return s.handleToolError(invalidInput(op, fmt.Sprintf("unknown kind %q; use names, comments or types", kind)))
+		}
+	}
+
+	fork, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	if fork.ParentID == "" {
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("session %s is not a fork", fork.ID)))
+	}
+	parent, ok := s.registry.Get(fork.ParentID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, fork.ParentID))
+	}
+	fork.Touch()
+	// Keep the parent from being evicted while it is written to
+	defer parent.Begin()()
+
+	forkClient, err := s.workers.GetClient(fork.ID)
+	if err != nil {
+		return s.handleToolError(workerUnavailable(op, fork.ID, err))
+	}
+	if parent.Dormant() {
+		if err := s.wakeSession(ctx, parent); err != nil {
+			return s.handleToolError(workerUnavailable(op, parent.ID, err))
+		}
+	}
+	// The call is scheduled on the fork; queue the writes on the parent too
+	release, err := s.holdWorker(ctx, parent.ID, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+	parentClient, err := s.workers.GetClient(parent.ID)
+	if err != nil {
+		return s.handleToolError(workerUnavailable(op, parent.ID, err))
+	}
+
+	changes := make([]annotationChange, 0)
+	var errs []string
+	for _, addr := range args.Addresses {
+		for _, kind := range kinds {
+			var merged []annotationChange
+			var err error
+			switch kind {
+			case annotationNames:
+				merged, err = s.mergeName(ctx, req, forkClient, parentClient, parent.ID, addr)
+			case annotationComments:
+				merged, err = s.mergeComments(ctx, req, forkClient, parentClient, parent.ID, addr)
+			case annotationTypes:
+				merged, err = s.mergeType(ctx, req, forkClient, parentClient, parent.ID, addr)
+			}
+			changes = append(changes, merged...)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("0x%x %s: %v", addr, kind, err))
+			}
+		}
+	}
+	if len(changes) > 0 {
+		s.deleteSessionCache(parent.ID)
+	}
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id": fork.ID,
+		"parent_id":  parent.ID,
+		"merged":     len(changes),
+		"changes":    changes,
+		"errors":     errs,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+// mergeWrite makes one merged change on the parent through the tool that
+// makes it, so it is journaled and can be undone there like any other.
+func (s *Server) mergeWrite(ctx context.Context, req *mcp.CallToolRequest, parentID string, addr uint64, step ReplayStep) error {
+	if reason := toolFailure(s.applyReplayStep(ctx, req, parentID, addr, step)); reason != "" {
+		return errors.New(reason)
+	}
+	return nil
+}
+
+// mergeName copies the fork's name at addr to the parent when they differ.
+func (s *Server) mergeName(ctx context.Context, req *mcp.CallToolRequest, fork, parent *worker.WorkerClient, parentID string, addr uint64) ([]annotationChange, error) {
+	forkResp, err := (*fork.Analysis).GetName(ctx, connect.NewRequest(&pb.GetNameRequest{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	name := forkResp.Msg.GetName()
+	parentResp, err := (*parent.Analysis).GetName(ctx, connect.NewRequest(&pb.GetNameRequest{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	if name == "" || name == parentResp.Msg.GetName() {
+		return nil, nil
+	}
+	if err := s.mergeWrite(ctx, req, parentID, addr, ReplayStep{Tool: "set_name", Value: name}); err != nil {
+		return nil, err
+	}
+	return []annotationChange{{Address: addr, Kind: "name", Value: name, Previous: parentResp.Msg.GetName()}}, nil
+}
+
+// mergeComments copies the fork's regular, repeatable and function comments
+// at addr to the parent when they differ.
+func (s *Server) mergeComments(ctx context.Context, req *mcp.CallToolRequest, fork, parent *worker.WorkerClient, parentID string, addr uint64) ([]annotationChange, error) {
+	var changes []annotationChange
+	for _, repeatable := range []bool{false, true} {
+		get := &pb.GetCommentRequest{Address: addr, Repeatable: repeatable}
+		forkResp, err := (*fork.Analysis).GetComment(ctx, connect.NewRequest(get))
+		if err != nil {
+			return changes, err
+		}
+		parentResp, err := (*parent.Analysis).GetComment(ctx, connect.NewRequest(get))
+		if err != nil {
+			return changes, err
+		}
+		comment, previous := forkResp.Msg.GetComment(), parentResp.Msg.GetComment()
+		if comment == "" || comment == previous {
+			continue
+		}
+		if err := s.mergeWrite(ctx, req, parentID, addr, ReplayStep{Tool: "set_comment", Value: comment, Repeatable: repeatable}); err != nil {
+			return changes, err
+		}
+		kind := "comment"
+		if repeatable {
+			kind = "repeatable_comment"
+		}
+		changes = append(changes, annotationChange{Address: addr, Kind: kind, Value: comment, Previous: previous})
+	}
+
+	forkResp, err := (*fork.Analysis).GetFuncComment(ctx, connect.NewRequest(&pb.GetFuncCommentRequest{Address: addr}))
+	if err != nil || forkResp.Msg.GetComment() == "" {
+		// Not every address is inside a function
+		return changes, nil
+	}
+	parentResp, err := (*parent.Analysis).GetFuncComment(ctx, connect.NewRequest(&pb.GetFuncCommentRequest{Address: addr}))
+	if err != nil {
+		return changes, err
+	}
+	comment, previous := forkResp.Msg.GetComment(), parentResp.Msg.GetComment()
+	if comment == previous {
+		return changes, nil
+	}
+	if err := s.mergeWrite(ctx, req, parentID, addr, ReplayStep{Tool: "set_func_comment", Value: comment}); err != nil {
+		return changes, err
+	}
+	return append(changes, annotationChange{Address: addr, Kind: "function_comment", Value: comment, Previous: previous}), nil
+}
+
+// mergeType copies the fork's type at addr to the parent when they differ,
+// as a function prototype or a global type.
+func (s *Server) mergeType(ctx context.Context, req *mcp.CallToolRequest, fork, parent *worker.WorkerClient, parentID string, addr uint64) ([]annotationChange, error) {
+	forkResp, err := (*fork.Analysis).GetTypeAt(ctx, connect.NewRequest(&pb.GetTypeAtRequest{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	if !forkResp.Msg.GetHasType() {
+		return nil, nil
+	}
+	parentResp, err := (*parent.Analysis).GetTypeAt(ctx, connect.NewRequest(&pb.GetTypeAtRequest{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	typ, previous := forkResp.Msg.GetType(), parentResp.Msg.GetType()
+	if typ == previous {
+		return nil, nil
+	}
+	step, kind := ReplayStep{Tool: "set_global_type", Value: typ}, "global_type"
+	if forkResp.Msg.GetIsFunc() {
+		step, kind = ReplayStep{Tool: "set_function_type", Value: typ}, "function_type"
+	}
+	if err := s.mergeWrite(ctx, req, parentID, addr, step); err != nil {
+		return nil, err
+	}
+	return []annotationChange{{Address: addr, Kind: kind, Value: typ, Previous: previous}}, nil
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// heavyTools load every core of a worker's host while they run. There is
+// no bulk decompilation tool; get_decompiled_func works on one function.
+var heavyTools = map[string]bool{
+	"run_auto_analysis": true,
+	"import_il2cpp":     true,
+	"import_flutter":    true,
+	"get_strings":       true,
+}
+
+// defaultMaxHeavyOperations leaves half the cores to everything else.
+func defaultMaxHeavyOperations() int {
+	return max(1, runtime.NumCPU()/2)
+}
+
+// heavyLimiter bounds the heavy operations running across all sessions.
+// Callers wait first come first served.
+type heavyLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	running int
+	waiting []*heavyWaiter
+	// changed is closed and replaced whenever the queue moves
+	changed chan struct{}
+}
+
+type heavyWaiter struct {
+	granted bool
+}
+
+func newHeavyLimiter(limit int) *heavyLimiter {
+	return &heavyLimiter{limit: limit, changed: make(chan struct{})}
+}
+
+// acquire waits for a slot or until ctx ends. While it waits, onWait is
+// called with the caller's position, from 1, and the queue length each
+// time they change.
+func (l *heavyLimiter) acquire(ctx context.Context, onWait func(position, queued int)) error {
+	l.mu.Lock()
+	if l.running < l.limit && len(l.waiting) == 0 {
+		l.running++
+		l.mu.Unlock()
+		return nil
+	}
+	w := &heavyWaiter{}
+	l.waiting = append(l.waiting, w)
+	var lastPosition, lastQueued int
+	for {
+		if w.granted {
+			l.mu.Unlock()
+			return nil
+		}
+		position, queued := l.position(w), len(l.waiting)
+		changed := l.changed
+		l.mu.Unlock()
+
+		if position != lastPosition || queued != lastQueued {
+			onWait(position, queued)
+			lastPosition, lastQueued = position, queued
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			l.mu.Lock()
+			if w.granted {
+				l.releaseLocked()
+			} else {
+				l.remove(w)
+				l.broadcast()
+			}
+			l.mu.Unlock()
+			return ctx.Err()
+		}
+		l.mu.Lock()
+	}
+}
+
+func (l *heavyLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.releaseLocked()
+}
+
+// releaseLocked hands the slot to the longest waiting caller.
+func (l *heavyLimiter) releaseLocked() {
+	l.running--
+	for l.running < l.limit && len(l.waiting) > 0 {
+		next := l.waiting[0]
+		l.waiting = l.waiting[1:]
+		next.granted = true
+		l.running++
+	}
+	l.broadcast()
+}
+
+func (l *heavyLimiter) position(w *heavyWaiter) int {
+	for i, waiting := range l.waiting {
+		if waiting == w {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (l *heavyLimiter) remove(w *heavyWaiter) {
+	if i := l.position(w); i > 0 {
+		l.waiting = append(l.waiting[:i-1], l.waiting[i:]...)
+	}
+}
+
+func (l *heavyLimiter) broadcast() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// SetMaxHeavyOperations sets how many heavy operations may run at once
+// across all sessions; 0 uses half the CPUs.
+func (s *Server) SetMaxHeavyOperations(n int) {
+	if n <= 0 {
+		n = defaultMaxHeavyOperations()
+	}
+	s.heavy = newHeavyLimiter(n)
+}
+
+// limitHeavyCalls holds each heavy tool call until it gets one of the
+// server-wide slots, reporting its place in line as progress. It runs once
+// the session queue has admitted the call, so a slot is never held by a
+// call still waiting for its own session's worker.
+func (s *Server) limitHeavyCalls(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		tool, sess := s.callSession(method, req)
+		if s.heavy == nil || !heavyTools[tool] {
+			return next(ctx, method, req)
+		}
+		var sessionID string
+		if sess != nil {
+			sessionID = sess.ID
+		}
+		call, _ := req.(*mcp.CallToolRequest)
+		progress := s.progressReporter(ctx, call, sessionID, "heavy_queue")
+		// The tool reports under the same token, so its progress carries on
+		// from the queue's
+		ctx = context.WithValue(ctx, progressReporterKey{}, progress)
+
+		var first int
+		err := s.heavy.acquire(ctx, func(position, queued int) {
+			if first == 0 {
+				first = position
+			}
+			msg := fmt.Sprintf("Waiting for a heavy operation slot: position %d of %d, %d running", position, queued, s.heavy.limit)
+			s.emitProgress(progress, sessionID, "heavy_queue", msg, float64(first-position), float64(first))
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer s.heavy.release()
+		if first > 0 {
+			s.emitProgress(progress, sessionID, "heavy_queue", fmt.Sprintf("Starting %s", tool), float64(first), float64(first))
+		}
+		return next(ctx, method, req)
+	}
+}
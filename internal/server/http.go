@@ -23,6 +23,9 @@ func (s *Server) HTTPMux(mcpServer *mcp.Server) http.Handler {
 	})
 
 	mux := http.NewServeMux()
+	// Session bundles, for moving an analysed database between servers
+	mux.HandleFunc("GET /sessions/{id}/export", s.handleExportSession)
+	mux.HandleFunc("POST /sessions/import", s.handleImportSession)
 	mux.Handle("/sse", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if s.debug {
 			s.logger.Printf("[SSE] %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
@@ -37,3 +40,85 @@ func (s *Server) HTTPMux(mcpServer *mcp.Server) http.Handler {
 	}))
 	return mux
 }
+
+// httpStatus maps a tool error onto the HTTP status of a non-MCP endpoint.
+func httpStatus(kind ErrorKind) int {
+	switch kind {
+	case ErrSessionNotFound:
+		return http.StatusNotFound
+	case ErrInvalidInput:
+		return http.StatusBadRequest
+	case ErrWorkerUnavailable, ErrResourceLimit:
+		return http.StatusServiceUnavailable
+	case ErrIDAOperation:
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+// writeHTTPJSON writes body as JSON with the given status.
+func (s *Server) writeHTTPJSON(w http.ResponseWriter, status int, body any) {
+	data, _ := s.marshalJSON(body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func (s *Server) writeHTTPError(w http.ResponseWriter, terr *ToolError) {
+	s.logger.Printf("[Error] %s", terr.Error())
+	if terr.Status == StatusTemporary {
+		w.Header().Set("Retry-After", "5")
+	}
+	s.writeHTTPJSON(w, httpStatus(terr.Kind), terr)
+}
+
+// handleExportSession streams a session bundle.
+func (s *Server) handleExportSession(w http.ResponseWriter, r *http.Request) {
+	const op = "export_session"
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		s.writeHTTPError(w, toolErr)
+		return
+	}
+	sess, ok := s.registry.Get(r.PathValue("id"))
+	if !ok {
+		s.writeHTTPError(w, sessionNotFound(op, r.PathValue("id")))
+		return
+	}
+	defer sess.Begin()()
+	// Unlike the tool, this is not a scheduled call; hold the worker so no
+	// save rewrites the database while it is archived
+	release, err := s.holdWorker(r.Context(), sess.ID, op)
+	if err != nil {
+		return
+	}
+	defer release()
+	dbPath, toolErr := s.prepareExport(r.Context(), op, sess)
+	if toolErr != nil {
+		s.writeHTTPError(w, toolErr)
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+sess.ID+bundleExt+`"`)
+	if _, err := writeBundle(w, sess, dbPath); err != nil {
+		// The status line is gone; the client sees a truncated archive
+		s.logger.Printf("[Export] Session %s export over HTTP failed: %v", sess.ID, err)
+		return
+	}
+	s.logger.Printf("[Export] Session %s exported to %s", sess.ID, r.RemoteAddr)
+}
+
+// handleImportSession opens the session bundle in the request body.
+func (s *Server) handleImportSession(w http.ResponseWriter, r *http.Request) {
+	const op = "import_session"
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		s.writeHTTPError(w, toolErr)
+		return
+	}
+	body := http.MaxBytesReader(w, r.Body, maxBundleBody(s.importLimit()))
+	sess, manifest, toolErr := s.importBundle(r.Context(), op, body)
+	if toolErr != nil {
+		s.writeHTTPError(w, toolErr)
+		return
+	}
+	s.writeHTTPJSON(w, http.StatusCreated, importResult(sess, manifest))
+}
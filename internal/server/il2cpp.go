@@ -44,6 +44,10 @@ func (s *Server) importIl2cpp(ctx context.Context, req *mcp.CallToolRequest, arg
 	if msgErr := resp.Msg.GetError(); msgErr != "" && !resp.Msg.GetSuccess() {
 		return nil, s.logAndSanitizeError("import_il2cpp IDA operation", errors.New(msgErr)), nil
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, "import_il2cpp", 0, args, nil, "")
+	}
 	result := map[string]any{
 		"success":            resp.Msg.GetSuccess(),
 		"duration_seconds":   resp.Msg.GetDurationSeconds(),
@@ -0,0 +1,250 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+// storageJournal holds one append-only change journal per session. Journals
+// are an audit trail and outlive their session.
+const storageJournal = "journal"
+
+// journalEntry is one mutation recorded in a session's journal.
+type journalEntry struct {
+	// Seq numbers entries from 1 in journal order; it is the line number
+	// and is not stored
+	Seq     int64     `json:"seq,omitempty"`
+	Time    time.Time `json:"time"`
+	Tool    string    `json:"tool"`
+	Address uint64    `json:"address"`
+	// Arguments of the tool call as the client sent them
+	Args json.RawMessage `json:"args"`
+	// Prior is the value before the change, nil when the worker cannot
+	// report it
+	Prior  *string        `json:"prior,omitempty"`
+	Value  string         `json:"value"`
+	Client clientIdentity `json:"client"`
+}
+
+// clientIdentity is the MCP client that made a change. Over the stateless
+// HTTP transport the initialize handshake is not kept, so only the HTTP
+// request identifies the client.
+type clientIdentity struct {
+	Name      string `json:"name,omitempty"`
+	Version   string `json:"version,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	// Authenticated user, when the transport carries a bearer token
+	User string `json:"user,omitempty"`
+}
+
+func callClient(req *mcp.CallToolRequest) clientIdentity {
+	var id clientIdentity
+	if req == nil {
+		return id
+	}
+	if req.Session != nil {
+		if params := req.Session.InitializeParams(); params != nil && params.ClientInfo != nil {
+			id.Name = params.ClientInfo.Name
+			id.Version = params.ClientInfo.Version
+		}
+	}
+	if req.Extra != nil {
+		id.UserAgent = req.Extra.Header.Get("User-Agent")
+		if req.Extra.TokenInfo != nil {
+			id.User = req.Extra.TokenInfo.UserID
+		}
+	}
+	return id
+}
+
+func (s *Server) journalPath(sessionID string) string {
+	return filepath.Join(s.databaseDir, storageJournal, sessionID+".jsonl")
+}
+
+// recordChange appends a successful mutation to the session's journal and
+// undo stack. A journal that cannot be written is logged and does not fail
+// the call, which has already changed the database.
+func (s *Server) recordChange(ctx context.Context, req *mcp.CallToolRequest, sess *session.Session, tool string, addr uint64, args any, prior *string, value string) {
+	raw, err := json.Marshal(args)
+	if err != nil {
+		s.logger.Printf("Warning: failed to record %s on session %s: %v", tool, sess.ID, err)
+		return
+	}
+	entry := journalEntry{
+		Time:    time.Now().UTC(),
+		Tool:    tool,
+		Address: addr,
+		Args:    raw,
+		Prior:   prior,
+		Value:   value,
+		Client:  callClient(req),
+	}
+	s.recordUndo(ctx, sess.ID, entry)
+	if s.databaseDir == "" {
+		return
+	}
+	if err := s.appendJournal(sess.ID, entry); err != nil {
+		s.logger.Printf("Warning: failed to journal %s on session %s: %v", tool, sess.ID, err)
+	}
+}
+
+func (s *Server) appendJournal(sessionID string, entry journalEntry) error {
+	s.journalMu.Lock()
+	defer s.journalMu.Unlock()
+	path := s.journalPath(sessionID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// readJournal returns the session's journal entries, oldest first.
+func (s *Server) readJournal(sessionID string) ([]journalEntry, error) {
+	s.journalMu.Lock()
+	defer s.journalMu.Unlock()
+	f, err := os.Open(s.journalPath(sessionID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []journalEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16<<20)
+	for seq := int64(1); scanner.Scan(); seq++ {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return entries, fmt.Errorf("journal line %d: %w", seq, err)
+		}
+		entry.Seq = seq
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Prior values of an annotation, read before it is changed. Each returns
+// nil when the worker cannot report it.
+
+func readName(ctx context.Context, client *worker.WorkerClient, addr uint64) *string {
+	resp, err := (*client.Analysis).GetName(ctx, connect.NewRequest(&pb.GetNameRequest{Address: addr}))
+	if err != nil || resp.Msg.GetError() != "" {
+		return nil
+	}
+	name := resp.Msg.GetName()
+	return &name
+}
+
+func readComment(ctx context.Context, client *worker.WorkerClient, addr uint64, repeatable bool) *string {
+	resp, err := (*client.Analysis).GetComment(ctx, connect.NewRequest(&pb.GetCommentRequest{Address: addr, Repeatable: repeatable}))
+	if err != nil || resp.Msg.GetError() != "" {
+		return nil
+	}
+	comment := resp.Msg.GetComment()
+	return &comment
+}
+
+func readFuncComment(ctx context.Context, client *worker.WorkerClient, addr uint64) *string {
+	resp, err := (*client.Analysis).GetFuncComment(ctx, connect.NewRequest(&pb.GetFuncCommentRequest{Address: addr}))
+	if err != nil || resp.Msg.GetError() != "" {
+		return nil
+	}
+	comment := resp.Msg.GetComment()
+	return &comment
+}
+
+func readType(ctx context.Context, client *worker.WorkerClient, addr uint64) *string {
+	resp, err := (*client.Analysis).GetTypeAt(ctx, connect.NewRequest(&pb.GetTypeAtRequest{Address: addr}))
+	if err != nil || resp.Msg.GetError() != "" {
+		return nil
+	}
+	var typ string
+	if resp.Msg.GetHasType() {
+		typ = resp.Msg.GetType()
+	}
+	return &typ
+}
+
+func (s *Server) getChangeLog(ctx context.Context, req *mcp.CallToolRequest, args GetChangeLogRequest) (*mcp.CallToolResult, any, error) {
+	const op = "get_change_log"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"address": args.Address, "tool": args.Tool, "since": args.Since, "until": args.Until})
+	if s.databaseDir == "" {
+		return s.handleToolError(invalidInput(op, "database_directory is not configured"))
+	}
+	offset, limit, err := normalizePagination(args.Offset, args.Limit)
+	if err != nil {
+		return s.handleToolError(invalidInput(op, err.Error()))
+	}
+	if args.Since != 0 && args.Until != 0 && args.Until < args.Since {
+		return s.handleToolError(invalidInput(op, "until must not be before since"))
+	}
+	// The journal outlives the session, so a closed session's ID is accepted
+	// as long as it cannot name a file outside the journal directory
+	if args.SessionID == "" || strings.ContainsAny(args.SessionID, `/\.`) {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	if _, err := os.Stat(s.journalPath(args.SessionID)); err != nil {
+		if _, ok := s.registry.Get(args.SessionID); !ok {
+			return s.handleToolError(sessionNotFound(op, args.SessionID))
+		}
+	}
+
+	entries, err := s.readJournal(args.SessionID)
+	if err != nil {
+		return s.handleToolError(internalError(op, err))
+	}
+	matched := make([]journalEntry, 0)
+	for _, entry := range entries {
+		switch {
+		case args.Address != 0 && entry.Address != args.Address:
+		case args.Tool != "" && entry.Tool != args.Tool:
+		case args.Since != 0 && entry.Time.Before(time.Unix(args.Since, 0)):
+		case args.Until != 0 && entry.Time.After(time.Unix(args.Until, 0)):
+		default:
+			matched = append(matched, entry)
+		}
+	}
+	total := len(matched)
+	page := matched[min(offset, total):min(offset+limit, total)]
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id": args.SessionID,
+		"changes":    page,
+		"total":      total,
+		"offset":     offset,
+		"limit":      limit,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// trackInFlight counts each tool call against the session it targets, so the
+// watchdog does not reap a session while one of its calls is running.
+func (s *Server) trackInFlight(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if _, sess := s.callSession(method, req); sess != nil {
+			end := sess.Begin()
+			defer end()
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// dormantSafeTools work on a dormant session without starting its worker.
+var dormantSafeTools = map[string]bool{
+	"close_binary":         true,
+	"keepalive_session":    true,
+	"update_session":       true,
+	"get_session_progress": true,
+	"get_worker_status":    true,
+	"get_worker_logs":      true,
+	"fork_session":         true,
+	"export_session":       true,
+	"list_snapshots":       true,
+	"restore_snapshot":     true,
+	"get_change_log":       true,
+	"list_undo_stack":      true,
+	"get_session_queue":    true,
+}
+
+// wakeDormant starts the worker of a dormant session the first time a tool
+// call targets it.
+func (s *Server) wakeDormant(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		tool, sess := s.callSession(method, req)
+		if sess != nil && sess.Dormant() && !dormantSafeTools[tool] {
+			// The binary may have been rebuilt while the session slept
+			if _, toolErr := s.checkBinary(tool, sess, session.FileIdentity{}); toolErr != nil {
+				result, _, _ := s.handleToolError(toolErr)
+				return result, nil
+			}
+			if err := s.wakeSession(ctx, sess); err != nil {
+				result, _, _ := s.handleToolError(workerUnavailable(tool, sess.ID, err))
+				return result, nil
+			}
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// callSession returns the tool name and the session a tools/call request
+// names in its session_id argument. The session is nil if there is none.
+func (s *Server) callSession(method string, req mcp.Request) (string, *session.Session) {
+	if method != "tools/call" {
+		return "", nil
+	}
+	call, ok := req.(*mcp.CallToolRequest)
+	if !ok || call.Params == nil || len(call.Params.Arguments) == 0 {
+		return "", nil
+	}
+	var args struct {
+		SessionID string `json:"session_id"`
+	}
+	if err := json.Unmarshal(call.Params.Arguments, &args); err != nil || args.SessionID == "" {
+		return call.Params.Name, nil
+	}
+	sess, _ := s.registry.Get(args.SessionID)
+	return call.Params.Name, sess
+}
@@ -3,14 +3,35 @@ package server
 // Parameter types for all MCP tool implementations
 
 type OpenBinaryRequest struct {
-	Path string `json:"path" mcp:"path to binary file"`
+	Path  string            `json:"path" mcp:"path to binary file"`
+	Label string            `json:"label,omitempty" mcp:"human-readable session label"`
+	Owner string            `json:"owner,omitempty" mcp:"agent or user the session belongs to"`
+	Tags  map[string]string `json:"tags,omitempty" mcp:"free-form key/value tags"`
 }
 
 type CloseBinaryRequest struct {
 	SessionID string `json:"session_id" mcp:"session identifier"`
 }
 
-type ListSessionsRequest struct{}
+type ListSessionsRequest struct {
+	Owner    string            `json:"owner,omitempty" mcp:"only sessions with this owner"`
+	Tags     map[string]string `json:"tags,omitempty" mcp:"only sessions carrying all these tags; an empty value matches any value"`
+	PathGlob string            `json:"path_glob,omitempty" mcp:"only sessions whose binary path matches this glob; a pattern without a separator matches the file name"`
+}
+
+type CloseAllSessionsRequest struct{}
+
+type UpdateSessionRequest struct {
+	SessionID string            `json:"session_id" mcp:"session identifier"`
+	Label     *string           `json:"label,omitempty" mcp:"new label; empty clears it"`
+	Owner     *string           `json:"owner,omitempty" mcp:"new owner; empty clears it"`
+	Tags      map[string]string `json:"tags,omitempty" mcp:"tags to set; an empty value removes the tag"`
+}
+
+type KeepaliveSessionRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+	Pin       *bool  `json:"pin,omitempty" mcp:"true exempts the session from idle reaping, false lifts the exemption; omit to only reset the idle timer"`
+}
 
 type SaveDatabaseRequest struct {
 	SessionID string `json:"session_id" mcp:"session identifier"`
@@ -20,6 +41,107 @@ type GetSessionProgressRequest struct {
 	SessionID string `json:"session_id" mcp:"session identifier"`
 }
 
+type GetWorkerStatusRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+}
+
+type GetSessionQueueRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+}
+
+type GetWorkerLogsRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+	Tail      int    `json:"tail,omitempty" mcp:"number of most recent lines (default 100)"`
+	Level     string `json:"level,omitempty" mcp:"minimum level: debug, info, warning, error or critical"`
+	Since     int64  `json:"since,omitempty" mcp:"only lines logged at or after this unix timestamp"`
+}
+
+type SnapshotDatabaseRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+	Label     string `json:"label,omitempty" mcp:"optional note describing the snapshot"`
+}
+
+type ListSnapshotsRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+}
+
+type RestoreSnapshotRequest struct {
+	SessionID  string `json:"session_id" mcp:"session identifier"`
+	SnapshotID int    `json:"snapshot_id" mcp:"snapshot to roll the database back to, from list_snapshots"`
+}
+
+type GetChangeLogRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier; closed sessions keep their change log"`
+	Address   uint64 `json:"address,omitempty" mcp:"only changes at this address (function address for local variables)"`
+	Tool      string `json:"tool,omitempty" mcp:"only changes made by this tool, e.g. set_name"`
+	Since     int64  `json:"since,omitempty" mcp:"only changes at or after this Unix time"`
+	Until     int64  `json:"until,omitempty" mcp:"only changes at or before this Unix time"`
+	Offset    int    `json:"offset,omitempty" mcp:"result offset"`
+	Limit     int    `json:"limit,omitempty" mcp:"page size (default 1000)"`
+}
+
+type GCDatabasesRequest struct {
+	DryRun bool `json:"dry_run,omitempty" mcp:"only report what would be deleted"`
+}
+
+type ExportSessionRequest struct {
+	SessionID  string `json:"session_id" mcp:"session identifier"`
+	OutputPath string `json:"output_path,omitempty" mcp:"where to write the bundle; defaults to <database_directory>/exports/<session_id>.idabundle.tar.gz"`
+}
+
+type ImportSessionRequest struct {
+	BundlePath string `json:"bundle_path" mcp:"path to a bundle written by export_session"`
+}
+
+type ForkSessionRequest struct {
+	SessionID string `json:"session_id" mcp:"session to fork"`
+}
+
+type MergeSessionAnnotationsRequest struct {
+	SessionID string   `json:"session_id" mcp:"forked session whose annotations are merged into its parent"`
+	Addresses []uint64 `json:"addresses" mcp:"addresses whose annotations to carry back"`
+	Kinds     []string `json:"kinds,omitempty" mcp:"annotations to merge: names, comments and/or types (default: all)"`
+}
+
+type ReplayAnnotationsRequest struct {
+	SessionID       string       `json:"session_id" mcp:"session the changes are applied to"`
+	SourceSessionID string       `json:"source_session_id,omitempty" mcp:"replay this session's change log (get_change_log), oldest first"`
+	Steps           []ReplayStep `json:"steps,omitempty" mcp:"explicit steps, applied after the source session's changes"`
+	Anchor          string       `json:"anchor,omitempty" mcp:"anchoring for the source session's changes: address (default) or pattern, which matches bytes read from the source session"`
+	PatternLength   int          `json:"pattern_length,omitempty" mcp:"bytes read for pattern anchors (default 16)"`
+	Overwrite       bool         `json:"overwrite,omitempty" mcp:"apply steps even where the target has its own name or comment"`
+	DryRun          bool         `json:"dry_run,omitempty" mcp:"only anchor the steps and report conflicts"`
+}
+
+// ReplayStep is one annotation change replay_annotations applies.
+type ReplayStep struct {
+	Tool       string `json:"tool" mcp:"set_name, delete_name, rename_global, set_comment, set_func_comment, set_global_type, set_function_type, set_lvar_type, rename_lvar or make_function"`
+	Address    uint64 `json:"address,omitempty" mcp:"address in the source database (function address for local variables)"`
+	Anchor     string `json:"anchor,omitempty" mcp:"how the address is found in the target: address (default), name or pattern"`
+	Name       string `json:"name,omitempty" mcp:"name anchor: a symbol present in the target"`
+	Pattern    string `json:"pattern,omitempty" mcp:"pattern anchor: hex bytes, ?? for any byte, that must match once in the target"`
+	Offset     int64  `json:"offset,omitempty" mcp:"added to the address a name or pattern anchor finds"`
+	Value      string `json:"value,omitempty" mcp:"new name, comment, type or prototype"`
+	Prior      string `json:"prior,omitempty" mcp:"value the change replaced in the source; the target holding it is not a conflict"`
+	LvarName   string `json:"lvar_name,omitempty" mcp:"local variable the change applies to"`
+	Repeatable bool   `json:"repeatable,omitempty" mcp:"repeatable comment"`
+}
+
+type UndoRequest struct {
+	SessionID        string `json:"session_id" mcp:"session identifier"`
+	Count            int    `json:"count,omitempty" mcp:"number of changes to revert, most recent first (default 1)"`
+	SkipIrreversible bool   `json:"skip_irreversible,omitempty" mcp:"drop changes that cannot be reverted from the stack and continue past them; they stay in the database"`
+}
+
+type RedoRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+	Count     int    `json:"count,omitempty" mcp:"number of reverted changes to re-apply (default 1)"`
+}
+
+type ListUndoStackRequest struct {
+	SessionID string `json:"session_id" mcp:"session identifier"`
+}
+
 type RunAutoAnalysisRequest struct {
 	SessionID string `json:"session_id" mcp:"session identifier"`
 }
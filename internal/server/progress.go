@@ -120,7 +120,15 @@ func (p *progressReporter) Emit(stage, message string, progress, total float64)
 	}
 }
 
+// progressReporterKey carries a reporter a middleware already made for
+// the call, so progress sent under its token keeps increasing.
+type progressReporterKey struct{}
+
 func (s *Server) progressReporter(ctx context.Context, req *mcp.CallToolRequest, sessionID, stage string) *progressReporter {
+	if p, ok := ctx.Value(progressReporterKey{}).(*progressReporter); ok {
+		p.stage = stage
+		return p
+	}
 	return newProgressReporter(ctx, req, s.logger, stage, func(stage, message string, progress, total float64) {
 		s.recordProgress(sessionID, stage, message, progress, total)
 	})
@@ -0,0 +1,491 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+// How replay_annotations finds a step's address in the target
+const (
+	anchorAddress = "address"
+	anchorName    = "name"
+	anchorPattern = "pattern"
+)
+
+const (
+	defaultPatternLength = 16
+	maxPatternLength     = 256
+)
+
+// Outcomes of a replayed step
+const (
+	replayApplied    = "applied"
+	replayUnchanged  = "unchanged" // the target already holds the value
+	replayWouldApply = "would_apply"
+	replayUnanchored = "unanchored"
+	replayConflict   = "conflict"
+	replayFailed     = "failed"
+	replaySkipped    = "skipped" // a change replay cannot make
+)
+
+// replayTools are the tools whose changes can be replayed. Names and
+// comments are checked for conflicts; types, local variables and functions
+// are applied over what IDA inferred.
+var replayTools = map[string]bool{
+	"set_name":          true,
+	"delete_name":       true,
+	"rename_global":     true,
+	"set_comment":       true,
+	"set_func_comment":  true,
+	"set_global_type":   true,
+	"set_function_type": true,
+	"set_lvar_type":     true,
+	"rename_lvar":       true,
+	"make_function":     true,
+}
+
+// mergedKindTools replays the annotations merge_session_annotations journals.
+var mergedKindTools = map[string]string{
+	"name":               "set_name",
+	"comment":            "set_comment",
+	"repeatable_comment": "set_comment",
+	"function_comment":   "set_func_comment",
+	"function_type":      "set_function_type",
+	"global_type":        "set_global_type",
+}
+
+// replayOutcome reports what happened to one step.
+type replayOutcome struct {
+	Step          int    `json:"step"`
+	Seq           int64  `json:"seq,omitempty"` // journal entry of the source session
+	Tool          string `json:"tool"`
+	SourceAddress uint64 `json:"source_address"`
+	TargetAddress uint64 `json:"target_address,omitempty"`
+	Status        string `json:"status"`
+	// Value the target holds where a conflicting step would write
+	Current string `json:"current,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// journalStep turns a journaled change into the step that repeats it.
+func journalStep(entry journalEntry, anchor string) ReplayStep {
+	var args struct {
+		Repeatable bool   `json:"repeatable"`
+		LvarName   string `json:"lvar_name"`
+		Kind       string `json:"kind"`
+	}
+	json.Unmarshal(entry.Args, &args)
+	step := ReplayStep{
+		Tool:       entry.Tool,
+		Address:    entry.Address,
+		Anchor:     anchor,
+		Value:      entry.Value,
+		LvarName:   args.LvarName,
+		Repeatable: args.Repeatable,
+	}
+	if entry.Prior != nil {
+		step.Prior = *entry.Prior
+	}
+	if entry.Tool == "merge_session_annotations" {
+		step.Tool = mergedKindTools[args.Kind]
+		step.Repeatable = args.Kind == "repeatable_comment"
+	}
+	return step
+}
+
+// generatedPrefixes start the dummy names IDA gives unnamed locations,
+// followed by the address in hex.
+var generatedPrefixes = []string{
+	"sub_", "loc_", "locret_", "nullsub_", "off_", "seg_", "asc_", "stru_",
+	"byte_", "word_", "dword_", "qword_", "xmmword_", "ymmword_", "unk_",
+	"flt_", "dbl_", "tbyte_", "algn_", "def_", "jpt_",
+}
+
+var hexDigits = regexp.MustCompile(`^[0-9A-Fa-f]+$`)
+
+func isGeneratedName(name string) bool {
+	for _, prefix := range generatedPrefixes {
+		if rest, ok := strings.CutPrefix(name, prefix); ok && hexDigits.MatchString(rest) {
+			return true
+		}
+	}
+	return false
+}
+
+// replayState decides whether a step may write over the target's current
+// value: it conflicts when the target holds a value of its own, which is
+// neither the step's value, the value the step replaced in the source, nor
+// one IDA generated. It returns "" when the step may be applied.
+func replayState(current *string, step ReplayStep, generated func(string) bool) string {
+	switch {
+	case current == nil:
+		return ""
+	case *current == step.Value:
+		return replayUnchanged
+	case *current == "" || *current == step.Prior || generated(*current):
+		return ""
+	}
+	return replayConflict
+}
+
+// replayAnchors resolves the target address of each step.
+type replayAnchors struct {
+	s             *Server
+	target        *session.Session
+	client        *worker.WorkerClient
+	patternLength int
+	// source returns the source session's client, for patterns read from it
+	source   func() (*worker.WorkerClient, error)
+	names    map[string]uint64
+	patterns map[uint64]string
+}
+
+// resolve returns the step's address in the target, or why it has none.
+func (a *replayAnchors) resolve(ctx context.Context, step ReplayStep) (uint64, string) {
+	var addr uint64
+	var reason string
+	switch step.Anchor {
+	case "", anchorAddress:
+		return step.Address, ""
+	case anchorName:
+		addr, reason = a.byName(ctx, step.Name)
+	case anchorPattern:
+		addr, reason = a.byPattern(ctx, step)
+	}
+	if reason != "" {
+		return 0, reason
+	}
+	return uint64(int64(addr) + step.Offset), ""
+}
+
+func (a *replayAnchors) byName(ctx context.Context, name string) (uint64, string) {
+	if name == "" {
+		return 0, "name anchor needs a name"
+	}
+	if a.names == nil {
+		functions, _, err := a.s.getSessionCache(a.target.ID).loadFunctions(a.target.ID, a.s.logger, func() ([]*pb.Function, error) {
+			return a.s.fetchAllFunctions(ctx, a.client, nil)
+		})
+		if err != nil {
+			return 0, fmt.Sprintf("list functions: %v", err)
+		}
+		a.names = make(map[string]uint64, len(functions))
+		for _, fn := range functions {
+			a.names[fn.GetName()] = fn.GetAddress()
+		}
+	}
+	if addr, ok := a.names[name]; ok {
+		return addr, ""
+	}
+	resp, err := (*a.client.Analysis).GetGlobals(ctx, connect.NewRequest(&pb.GetGlobalsRequest{
+		Regex:         "^" + regexp.QuoteMeta(name) + "$",
+		CaseSensitive: true,
+	}))
+	if err != nil {
+		return 0, fmt.Sprintf("look up %s: %v", name, err)
+	}
+	for _, global := range resp.Msg.GetGlobals() {
+		if global.GetName() == name {
+			return global.GetAddress(), ""
+		}
+	}
+	return 0, fmt.Sprintf("no symbol named %s in the target", name)
+}
+
+func (a *replayAnchors) byPattern(ctx context.Context, step ReplayStep) (uint64, string) {
+	pattern := step.Pattern
+	if pattern == "" {
+		var reason string
+		if pattern, reason = a.sourcePattern(ctx, step.Address); reason != "" {
+			return 0, reason
+		}
+	}
+	resp, err := (*a.client.Analysis).FindBinary(ctx, connect.NewRequest(&pb.FindBinaryRequest{Pattern: pattern}))
+	if err == nil && resp.Msg.GetError() != "" {
+		err = errors.New(resp.Msg.GetError())
+	}
+	if err != nil {
+		return 0, fmt.Sprintf("search pattern: %v", err)
+	}
+	switch matches := resp.Msg.GetAddresses(); len(matches) {
+	case 0:
+		return 0, "pattern not found in the target"
+	case 1:
+		return matches[0], ""
+	default:
+		return 0, fmt.Sprintf("pattern matches %d places in the target", len(matches))
+	}
+}
+
+// sourcePattern reads the bytes at addr in the source session as a pattern.
+func (a *replayAnchors) sourcePattern(ctx context.Context, addr uint64) (string, string) {
+	if pattern, ok := a.patterns[addr]; ok {
+		return pattern, ""
+	}
+	if a.source == nil {
+		return "", "pattern anchor needs a pattern or a source session"
+	}
+	client, err := a.source()
+	if err != nil {
+		return "", fmt.Sprintf("source session: %v", err)
+	}
+	resp, err := (*client.Analysis).GetBytes(ctx, connect.NewRequest(&pb.GetBytesRequest{
+		Address: addr,
+		Size:    uint32(a.patternLength),
+	}))
+	if err == nil && resp.Msg.GetError() != "" {
+		err = errors.New(resp.Msg.GetError())
+	}
+	if err != nil {
+		return "", fmt.Sprintf("read source bytes: %v", err)
+	}
+	data := resp.Msg.GetData()
+	if len(data) == 0 {
+		return "", "no source bytes at the address"
+	}
+	encoded := hex.EncodeToString(data)
+	bytes := make([]string, 0, len(data))
+	for i := 0; i < len(encoded); i += 2 {
+		bytes = append(bytes, encoded[i:i+2])
+	}
+	pattern := strings.Join(bytes, " ")
+	a.patterns[addr] = pattern
+	return pattern, ""
+}
+
+// currentValue reads what the target holds where a name or comment step
+// writes, with the test for values IDA generated. It returns nil for steps
+// that are not checked for conflicts.
+func currentValue(ctx context.Context, client *worker.WorkerClient, step ReplayStep, addr uint64) (*string, func(string) bool) {
+	none := func(string) bool { return false }
+	switch step.Tool {
+	case "set_name", "delete_name", "rename_global":
+		return readName(ctx, client, addr), isGeneratedName
+	case "set_comment":
+		return readComment(ctx, client, addr, step.Repeatable), none
+	case "set_func_comment":
+		return readFuncComment(ctx, client, addr), none
+	}
+	return nil, none
+}
+
+// applyReplayStep makes the step's change through the tool that made it in
+// the source, so it is journaled like any other change.
+func (s *Server) applyReplayStep(ctx context.Context, req *mcp.CallToolRequest, sessionID string, addr uint64, step ReplayStep) *mcp.CallToolResult {
+	var result *mcp.CallToolResult
+	switch step.Tool {
+	case "set_name":
+		result, _, _ = s.setName(ctx, req, SetNameRequest{SessionID: sessionID, Address: addr, Name: step.Value})
+	case "delete_name":
+		result, _, _ = s.deleteName(ctx, req, DeleteNameRequest{SessionID: sessionID, Address: addr})
+	case "rename_global":
+		result, _, _ = s.renameGlobal(ctx, req, RenameGlobalRequest{SessionID: sessionID, Address: addr, NewName: step.Value})
+	case "set_comment":
+		result, _, _ = s.setComment(ctx, req, SetCommentRequest{SessionID: sessionID, Address: addr, Comment: step.Value, Repeatable: step.Repeatable})
+	case "set_func_comment":
+		result, _, _ = s.setFuncComment(ctx, req, SetFuncCommentRequest{SessionID: sessionID, Address: addr, Comment: step.Value})
+	case "set_global_type":
+		result, _, _ = s.setGlobalType(ctx, req, SetGlobalTypeRequest{SessionID: sessionID, Address: addr, Type: step.Value})
+	case "set_function_type":
+		result, _, _ = s.setFunctionType(ctx, req, SetFunctionTypeRequest{SessionID: sessionID, Address: addr, Prototype: step.Value})
+	case "set_lvar_type":
+		result, _, _ = s.setLvarType(ctx, req, SetLvarTypeRequest{SessionID: sessionID, FunctionAddress: addr, LvarName: step.LvarName, LvarType: step.Value})
+	case "rename_lvar":
+		result, _, _ = s.renameLvar(ctx, req, RenameLvarRequest{SessionID: sessionID, FunctionAddress: addr, LvarName: step.LvarName, NewName: step.Value})
+	case "make_function":
+		result, _, _ = s.makeFunction(ctx, req, MakeFunctionRequest{SessionID: sessionID, Address: addr})
+	}
+	return result
+}
+
+// toolFailure returns why a tool call failed, or "" if it succeeded.
+func toolFailure(result *mcp.CallToolResult) string {
+	if result == nil || len(result.Content) == 0 {
+		return "no result"
+	}
+	text, _ := result.Content[0].(*mcp.TextContent)
+	if text == nil {
+		return ""
+	}
+	if result.IsError {
+		var terr ToolError
+		if json.Unmarshal([]byte(text.Text), &terr) == nil && terr.Message != "" {
+			return terr.Message
+		}
+		return text.Text
+	}
+	var body struct {
+		Success *bool `json:"success"`
+	}
+	if json.Unmarshal([]byte(text.Text), &body) == nil && body.Success != nil && !*body.Success {
+		return "the worker did not apply the change"
+	}
+	return ""
+}
+
+func (s *Server) replayAnnotations(ctx context.Context, req *mcp.CallToolRequest, args ReplayAnnotationsRequest) (*mcp.CallToolResult, any, error) {
+	const op = "replay_annotations"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"source_session_id": args.SourceSessionID, "steps": len(args.Steps), "dry_run": args.DryRun})
+	if args.SourceSessionID == "" && len(args.Steps) == 0 {
+		return s.handleToolError(invalidInput(op, "source_session_id or steps is required"))
+	}
+	if args.SourceSessionID == args.SessionID {
+		return s.handleToolError(invalidInput(op, "source_session_id must name another session"))
+	}
+	switch args.Anchor {
+	case "", anchorAddress, anchorPattern:
+	default:
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("anchor must be %q or %q for a source session's changes", anchorAddress, anchorPattern)))
+	}
+	patternLength := args.PatternLength
+	if patternLength == 0 {
+		patternLength = defaultPatternLength
+	}
+	if patternLength < 1 || patternLength > maxPatternLength {
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("pattern_length must be between 1 and %d", maxPatternLength)))
+	}
+	for i, step := range args.Steps {
+		if !replayTools[step.Tool] {
+			return s.handleToolError(invalidInput(op, fmt.Sprintf("step %d: tool %q cannot be replayed", i+1, step.Tool)))
+		}
+		switch step.Anchor {
+		case "", anchorAddress, anchorName, anchorPattern:
+		default:
+			return s.handleToolError(invalidInput(op, fmt.Sprintf("step %d: anchor must be address, name or pattern", i+1)))
+		}
+	}
+
+	target, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	target.Touch()
+	client, err := s.workers.GetClient(target.ID)
+	if err != nil {
+		return s.handleToolError(workerUnavailable(op, target.ID, err))
+	}
+
+	anchors := &replayAnchors{
+		s:             s,
+		target:        target,
+		client:        client,
+		patternLength: patternLength,
+		patterns:      make(map[uint64]string),
+	}
+	var steps []ReplayStep
+	var seqs []int64
+	if args.SourceSessionID != "" {
+		if s.databaseDir == "" {
+			return s.handleToolError(invalidInput(op, "database_directory is not configured"))
+		}
+		entries, err := s.readJournal(args.SourceSessionID)
+		if err != nil {
+			return s.handleToolError(internalError(op, err))
+		}
+		source, open := s.registry.Get(args.SourceSessionID)
+		if len(entries) == 0 && !open {
+			return s.handleToolError(sessionNotFound(op, args.SourceSessionID))
+		}
+		for _, entry := range entries {
+			steps = append(steps, journalStep(entry, args.Anchor))
+			seqs = append(seqs, entry.Seq)
+		}
+		if open {
+			// Keep the source from being evicted while its bytes are read
+			defer source.Begin()()
+			var sourceClient *worker.WorkerClient
+			var sourceErr error
+			anchors.source = func() (*worker.WorkerClient, error) {
+				if sourceClient == nil && sourceErr == nil {
+					if source.Dormant() {
+						sourceErr = s.wakeSession(ctx, source)
+					}
+					if sourceErr == nil {
+						sourceClient, sourceErr = s.workers.GetClient(source.ID)
+					}
+				}
+				return sourceClient, sourceErr
+			}
+		}
+	}
+	steps = append(steps, args.Steps...)
+
+	outcomes := make([]replayOutcome, 0, len(steps))
+	counts := make(map[string]int)
+	for i, step := range steps {
+		outcome := replayOutcome{Step: i + 1, Tool: step.Tool, SourceAddress: step.Address}
+		if i < len(seqs) {
+			outcome.Seq = seqs[i]
+		}
+		outcome.Status, outcome.TargetAddress, outcome.Current, outcome.Reason = s.replayStep(ctx, req, target, anchors, step, args.Overwrite, args.DryRun)
+		counts[outcome.Status]++
+		outcomes = append(outcomes, outcome)
+	}
+	if counts[replayApplied] > 0 {
+		s.deleteSessionCache(target.ID)
+	}
+	s.logger.Printf("[Replay] Session %s: %d applied, %d unanchored, %d conflicts, %d failed of %d steps",
+		target.ID, counts[replayApplied], counts[replayUnanchored], counts[replayConflict], counts[replayFailed], len(steps))
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id":        target.ID,
+		"source_session_id": args.SourceSessionID,
+		"dry_run":           args.DryRun,
+		"steps":             len(steps),
+		"applied":           counts[replayApplied],
+		"would_apply":       counts[replayWouldApply],
+		"unchanged":         counts[replayUnchanged],
+		"unanchored":        counts[replayUnanchored],
+		"conflicts":         counts[replayConflict],
+		"failed":            counts[replayFailed],
+		"skipped":           counts[replaySkipped],
+		"results":           outcomes,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+// replayStep anchors one step in the target and applies it.
+func (s *Server) replayStep(ctx context.Context, req *mcp.CallToolRequest, target *session.Session, anchors *replayAnchors, step ReplayStep, overwrite, dryRun bool) (status string, addr uint64, current, reason string) {
+	if !replayTools[step.Tool] {
+		return replaySkipped, 0, "", "this change cannot be replayed"
+	}
+	addr, reason = anchors.resolve(ctx, step)
+	if reason != "" {
+		return replayUnanchored, 0, "", reason
+	}
+	if addr == 0 {
+		return replayUnanchored, 0, "", "no address"
+	}
+
+	value, generated := currentValue(ctx, anchors.client, step, addr)
+	switch replayState(value, step, generated) {
+	case replayUnchanged:
+		return replayUnchanged, addr, "", ""
+	case replayConflict:
+		if !overwrite {
+			return replayConflict, addr, *value, "the target has its own value; pass overwrite to replace it"
+		}
+	}
+	if dryRun {
+		return replayWouldApply, addr, "", ""
+	}
+	if reason := toolFailure(s.applyReplayStep(ctx, req, target.ID, addr, step)); reason != "" {
+		return replayFailed, addr, "", reason
+	}
+	return replayApplied, addr, "", ""
+}
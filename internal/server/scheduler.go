@@ -0,0 +1,426 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Priorities of tool calls waiting for a session's worker, highest first
+const (
+	priorityInteractive = iota
+	priorityNormal
+	priorityBulk
+)
+
+var priorityNames = [...]string{"interactive", "normal", "bulk"}
+
+// priorityAging promotes a queued call one priority for each period it
+// waits, so bulk calls still run under a steady stream of reads.
+const priorityAging = 30 * time.Second
+
+// callClass is how the scheduler treats a tool's calls.
+type callClass struct {
+	priority int
+	// read calls return the same result for the same arguments, so one
+	// identical to a call in flight shares its result
+	read bool
+}
+
+// toolClasses classifies reads and long operations. Other tools, writes
+// and session management, run at priorityNormal and never share results.
+var toolClasses = map[string]callClass{
+	"get_bytes":              {priorityInteractive, true},
+	"get_disasm":             {priorityInteractive, true},
+	"get_function_disasm":    {priorityInteractive, true},
+	"get_decompiled_func":    {priorityInteractive, true},
+	"get_xrefs_to":           {priorityInteractive, true},
+	"get_xrefs_from":         {priorityInteractive, true},
+	"get_data_refs":          {priorityInteractive, true},
+	"get_string_xrefs":       {priorityInteractive, true},
+	"get_comment":            {priorityInteractive, true},
+	"get_func_comment":       {priorityInteractive, true},
+	"get_struct":             {priorityInteractive, true},
+	"get_enum":               {priorityInteractive, true},
+	"get_function_info":      {priorityInteractive, true},
+	"get_type_at":            {priorityInteractive, true},
+	"get_name":               {priorityInteractive, true},
+	"get_function_name":      {priorityInteractive, true},
+	"get_entry_point":        {priorityInteractive, true},
+	"get_segments":           {priorityInteractive, true},
+	"get_dword_at":           {priorityInteractive, true},
+	"get_qword_at":           {priorityInteractive, true},
+	"get_instruction_length": {priorityInteractive, true},
+	"data_read_string":       {priorityInteractive, true},
+	"data_read_byte":         {priorityInteractive, true},
+
+	"get_functions":             {priorityBulk, true},
+	"get_imports":               {priorityBulk, true},
+	"get_exports":               {priorityBulk, true},
+	"get_strings":               {priorityBulk, true},
+	"get_globals":               {priorityBulk, true},
+	"list_structs":              {priorityBulk, true},
+	"list_enums":                {priorityBulk, true},
+	"find_binary":               {priorityBulk, true},
+	"find_text":                 {priorityBulk, true},
+	"run_auto_analysis":         {priorityBulk, false},
+	"import_il2cpp":             {priorityBulk, false},
+	"import_flutter":            {priorityBulk, false},
+	"replay_annotations":        {priorityBulk, false},
+	"merge_session_annotations": {priorityBulk, false},
+}
+
+// unscheduledTools do not wait for the worker: they make no worker calls,
+// or only watch one that is running.
+var unscheduledTools = map[string]bool{
+	"close_binary":         true,
+	"update_session":       true,
+	"keepalive_session":    true,
+	"list_snapshots":       true,
+	"get_change_log":       true,
+	"list_undo_stack":      true,
+	"get_session_progress": true,
+	"get_worker_status":    true,
+	"get_worker_logs":      true,
+	"watch_auto_analysis":  true,
+	"get_session_queue":    true,
+}
+
+func classFor(tool string) callClass {
+	if class, ok := toolClasses[tool]; ok {
+		return class
+	}
+	return callClass{priority: priorityNormal}
+}
+
+// scheduledCall is a tool call queued for or holding a session's worker.
+type scheduledCall struct {
+	tool      string
+	priority  int
+	queuedAt  time.Time
+	startedAt time.Time
+	read      *sharedRead
+	ready     chan struct{}
+}
+
+// sharedRead carries the result of a read call to identical calls that
+// arrived while it was in flight.
+type sharedRead struct {
+	waiters int
+	done    chan struct{}
+	// abandoned is set when the call was cancelled; the calls sharing it
+	// then run themselves
+	abandoned bool
+	result    mcp.Result
+	err       error
+}
+
+// queueStats totals the calls of one priority a session's worker ran.
+type queueStats struct {
+	completed int
+	coalesced int
+	totalWait time.Duration
+	maxWait   time.Duration
+}
+
+// sessionQueue runs a session's tool calls one at a time, in priority
+// order and first come first served within a priority.
+type sessionQueue struct {
+	mu      sync.Mutex
+	running *scheduledCall
+	queued  []*scheduledCall
+	reads   map[string]*sharedRead
+	stats   [len(priorityNames)]queueStats
+}
+
+func (s *Server) sessionQueue(sessionID string) *sessionQueue {
+	s.queuesMu.Lock()
+	defer s.queuesMu.Unlock()
+	if s.queues == nil {
+		s.queues = make(map[string]*sessionQueue)
+	}
+	q := s.queues[sessionID]
+	if q == nil {
+		q = &sessionQueue{reads: make(map[string]*sharedRead)}
+		s.queues[sessionID] = q
+	}
+	return q
+}
+
+// dropQueue forgets a closed session's queue. Calls still holding it finish
+// normally.
+func (s *Server) dropQueue(sessionID string) {
+	s.queuesMu.Lock()
+	defer s.queuesMu.Unlock()
+	delete(s.queues, sessionID)
+}
+
+// acquire waits until the call may use the worker, or ctx ends. read is
+// the shared read the call makes, if any.
+func (q *sessionQueue) acquire(ctx context.Context, tool string, priority int, read *sharedRead) (*scheduledCall, error) {
+	q.mu.Lock()
+	call := &scheduledCall{tool: tool, priority: priority, queuedAt: time.Now(), read: read, ready: make(chan struct{})}
+	q.queued = append(q.queued, call)
+	if q.running == nil {
+		q.startNext()
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-call.ready:
+		return call, nil
+	case <-ctx.Done():
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.running == call {
+		q.finish(call)
+	} else {
+		q.remove(call)
+	}
+	return nil, ctx.Err()
+}
+
+// release hands the worker to the next queued call.
+func (q *sessionQueue) release(call *scheduledCall) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.finish(call)
+}
+
+func (q *sessionQueue) finish(call *scheduledCall) {
+	stats := &q.stats[call.priority]
+	stats.completed++
+	wait := call.startedAt.Sub(call.queuedAt)
+	stats.totalWait += wait
+	stats.maxWait = max(stats.maxWait, wait)
+	q.running = nil
+	q.startNext()
+}
+
+// startNext starts the queued call with the highest priority after aging.
+func (q *sessionQueue) startNext() {
+	if len(q.queued) == 0 {
+		return
+	}
+	now := time.Now()
+	next := q.queued[0]
+	for _, call := range q.queued[1:] {
+		if call.effectivePriority(now) < next.effectivePriority(now) {
+			next = call
+		}
+	}
+	q.remove(next)
+	next.startedAt = now
+	q.running = next
+	close(next.ready)
+}
+
+func (q *sessionQueue) remove(call *scheduledCall) {
+	for i, queued := range q.queued {
+		if queued == call {
+			q.queued = append(q.queued[:i], q.queued[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *scheduledCall) effectivePriority(now time.Time) int {
+	return c.priority - int(now.Sub(c.queuedAt)/priorityAging)
+}
+
+// holdWorker takes a session's worker through its queue, at normal
+// priority, for work the server does outside a tool call on that session,
+// so it never runs in the middle of one. task is the name
+// get_session_queue shows. Call release when done.
+func (s *Server) holdWorker(ctx context.Context, sessionID, task string) (release func(), err error) {
+	q := s.sessionQueue(sessionID)
+	call, err := q.acquire(ctx, task, priorityNormal, nil)
+	if err != nil {
+		return nil, err
+	}
+	return func() { q.release(call) }, nil
+}
+
+// shareRead returns the read in flight under key and false, or registers
+// a new one and true when there is none.
+func (q *sessionQueue) shareRead(key string, priority int) (*sharedRead, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if shared, ok := q.reads[key]; ok {
+		shared.waiters++
+		q.stats[priority].coalesced++
+		return shared, false
+	}
+	shared := &sharedRead{done: make(chan struct{})}
+	q.reads[key] = shared
+	return shared, true
+}
+
+func (q *sessionQueue) finishRead(key string, shared *sharedRead, result mcp.Result, err error, abandoned bool) {
+	q.mu.Lock()
+	delete(q.reads, key)
+	q.mu.Unlock()
+	shared.result, shared.err, shared.abandoned = result, err, abandoned
+	close(shared.done)
+}
+
+// readKey identifies a read call by its tool and arguments, with the
+// arguments re-encoded so their order does not matter.
+func readKey(tool string, req mcp.Request) string {
+	call, ok := req.(*mcp.CallToolRequest)
+	if !ok || call.Params == nil {
+		return ""
+	}
+	var args map[string]any
+	if err := json.Unmarshal(call.Params.Arguments, &args); err != nil {
+		return ""
+	}
+	canonical, err := json.Marshal(args)
+	if err != nil {
+		return ""
+	}
+	return tool + "\x00" + string(canonical)
+}
+
+// scheduleCalls queues each tool call on a session until the session's
+// worker is free. Concurrent calls otherwise reach the worker in no
+// particular order, and a quick read can wait behind a long enumeration.
+func (s *Server) scheduleCalls(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		tool, sess := s.callSession(method, req)
+		if sess == nil || unscheduledTools[tool] {
+			return next(ctx, method, req)
+		}
+		q := s.sessionQueue(sess.ID)
+		class := classFor(tool)
+
+		var key string
+		var shared *sharedRead
+		if class.read {
+			key = readKey(tool, req)
+		}
+		if key != "" {
+			var leader bool
+			shared, leader = q.shareRead(key, class.priority)
+			if !leader {
+				select {
+				case <-shared.done:
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+				if !shared.abandoned {
+					return shared.result, shared.err
+				}
+				shared, key = nil, ""
+			}
+		}
+
+		call, err := q.acquire(ctx, tool, class.priority, shared)
+		if err != nil {
+			if shared != nil {
+				q.finishRead(key, shared, nil, nil, true)
+			}
+			return nil, err
+		}
+		result, err := next(ctx, method, req)
+		// Retire the read before the worker moves on, so a read arriving
+		// after a write that starts next cannot share a result from before it
+		if shared != nil {
+			q.finishRead(key, shared, result, err, ctx.Err() != nil)
+		}
+		q.release(call)
+		return result, err
+	}
+}
+
+// queuedCallInfo describes a call in get_session_queue.
+type queuedCallInfo struct {
+	Tool        string    `json:"tool"`
+	Priority    string    `json:"priority"`
+	QueuedAt    time.Time `json:"queued_at"`
+	WaitSeconds float64   `json:"wait_seconds"`
+	StartedAt   time.Time `json:"started_at,omitzero"`
+	// RunningSeconds is set for the call holding the worker
+	RunningSeconds float64 `json:"running_seconds,omitempty"`
+	// Coalesced counts identical reads waiting for this call's result
+	Coalesced int `json:"coalesced"`
+}
+
+func (c *scheduledCall) info(now time.Time) queuedCallInfo {
+	info := queuedCallInfo{
+		Tool:     c.tool,
+		Priority: priorityNames[c.priority],
+		QueuedAt: c.queuedAt,
+	}
+	if c.read != nil {
+		info.Coalesced = c.read.waiters
+	}
+	if c.startedAt.IsZero() {
+		info.WaitSeconds = now.Sub(c.queuedAt).Seconds()
+	} else {
+		info.WaitSeconds = c.startedAt.Sub(c.queuedAt).Seconds()
+		info.StartedAt = c.startedAt
+		info.RunningSeconds = now.Sub(c.startedAt).Seconds()
+	}
+	return info
+}
+
+func (s *Server) getSessionQueue(ctx context.Context, req *mcp.CallToolRequest, args GetSessionQueueRequest) (*mcp.CallToolResult, any, error) {
+	const op = "get_session_queue"
+	s.logToolInvocation(op, args.SessionID, nil)
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+
+	q := s.sessionQueue(sess.ID)
+	now := time.Now()
+	q.mu.Lock()
+	var running *queuedCallInfo
+	if q.running != nil {
+		info := q.running.info(now)
+		running = &info
+	}
+	// Listed in the order they would start now
+	order := append([]*scheduledCall(nil), q.queued...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].effectivePriority(now) < order[j].effectivePriority(now)
+	})
+	queued := make([]queuedCallInfo, 0, len(order))
+	for _, call := range order {
+		queued = append(queued, call.info(now))
+	}
+	stats := make(map[string]any, len(priorityNames))
+	for priority, name := range priorityNames {
+		st := q.stats[priority]
+		var avgWait float64
+		if st.completed > 0 {
+			avgWait = (st.totalWait / time.Duration(st.completed)).Seconds()
+		}
+		stats[name] = map[string]any{
+			"completed":        st.completed,
+			"coalesced":        st.coalesced,
+			"avg_wait_seconds": avgWait,
+			"max_wait_seconds": st.maxWait.Seconds(),
+		}
+	}
+	q.mu.Unlock()
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id": sess.ID,
+		"depth":      len(queued),
+		"running":    running,
+		"queued":     queued,
+		"stats":      stats,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
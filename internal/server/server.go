@@ -23,6 +23,7 @@ const (
 	DefaultPort              = 17300
 	defaultSessionTimeoutMin = 60  // 60 minutes — prevents forgotten sessions from lingering
 	defaultAutoSaveMin       = 5
+	autoSaveTimeout          = 2 * time.Minute
 	defaultMaxSessions       = 10  // cap concurrent sessions to prevent resource exhaustion
 	defaultWorkerPath        = "python/worker/server.py"
 	defaultPageLimit         = 1000
@@ -37,8 +38,72 @@ type Config struct {
 	DatabaseDirectory    string `json:"database_directory"`
 	PythonWorkerPath     string `json:"python_worker_path"`
 	Debug                bool   `json:"debug"`
+
+	// Worker resource limits; 0 means unlimited
+	WorkerMemoryLimitMB int `json:"worker_memory_limit_mb"`
+	WorkerCPUPercent    int `json:"worker_cpu_percent"`
+	// Host admission: open_binary waits up to AdmissionWaitSec for at least
+	// MinFreeMemoryMB of available memory, then refuses
+	MinFreeMemoryMB  int `json:"min_free_memory_mb"`
+	AdmissionWaitSec int `json:"admission_wait_seconds"`
+	// Idle workers kept pre-initialised for open_binary; 0 disables the pool
+	WorkerPoolSize int `json:"worker_pool_size"`
+	// Worker output kept per session for get_worker_logs; 0 uses the default.
+	// WorkerLogFiles also mirrors it to rotating files under DatabaseDirectory/logs
+	WorkerLogLines int  `json:"worker_log_lines"`
+	WorkerLogFiles bool `json:"worker_log_files"`
+	// Remote worker agents (host:port). When set, sessions run on these hosts
+	// instead of local worker processes, over mutual TLS
+	WorkerHosts []string `json:"worker_hosts"`
+	TLSCertFile string   `json:"tls_cert_file"`
+	TLSKeyFile  string   `json:"tls_key_file"`
+	TLSCAFile   string   `json:"tls_ca_file"`
+	// What open_binary does at max_concurrent_sessions: "reject" (default)
+	// fails, "evict_lru" saves and stops the least recently active idle
+	// session, which restarts on its next use. Sessions for PinnedBinaries
+	// start pinned and are never evicted or reaped
+	SessionEviction string   `json:"session_eviction"`
+	PinnedBinaries  []string `json:"pinned_binaries"`
+	// What happens when a binary changed on disk since its session's database
+	// was built: "warn" (default) reports it, "refuse" fails the call
+	StaleBinary string `json:"stale_binary"`
+	// Disk quotas for databases, forks, imports and snapshots kept under
+	// DatabaseDirectory, in total and per session; 0 means unlimited
+	DatabaseQuotaMB int `json:"database_quota_mb"`
+	SessionQuotaMB  int `json:"session_quota_mb"`
+	// Where session metadata is kept under DatabaseDirectory: "files"
+	// (default) writes one JSON file per session under sessions/, "bolt" an
+	// embedded key-value database, sessions.db
+	SessionStore string `json:"session_store"`
+	// Deadlines for tool calls in seconds: ToolTimeouts by tool name, over
+	// built-in defaults such as 60 for get_decompiled_func, and
+	// ToolTimeoutSec for other tools. 0 means no deadline. A worker still
+	// busy with a call past its deadline is restarted from its last save
+	ToolTimeoutSec int            `json:"tool_timeout_seconds"`
+	ToolTimeouts   map[string]int `json:"tool_timeouts"`
+	// Heavy operations (auto-analysis, imports, string enumeration) allowed
+	// to run at once across all sessions; 0 uses half the CPUs
+	MaxHeavyOperations int `json:"max_heavy_operations"`
 }
 
+// Session eviction policies
+const (
+	EvictionReject = "reject"
+	EvictionLRU    = "evict_lru"
+)
+
+// Stale binary policies
+const (
+	StaleBinaryWarn   = "warn"
+	StaleBinaryRefuse = "refuse"
+)
+
+// Session store backends
+const (
+	SessionStoreFiles = "files"
+	SessionStoreBolt  = "bolt"
+)
+
 type Server struct {
 	registry       *session.Registry
 	workers        worker.Controller
@@ -50,6 +115,20 @@ type Server struct {
 	cache          map[string]*sessionCache
 	progressMu     sync.Mutex
 	progress       map[string]*sessionProgress
+	eviction       string
+	pinnedBinaries map[string]bool
+	staleBinary    string
+	databaseDir    string
+	databaseQuota  int64 // bytes; 0 is unlimited
+	sessionQuota   int64
+	journalMu      sync.Mutex
+	historyMu      sync.Mutex
+	history        map[string]*undoHistory
+	queuesMu       sync.Mutex
+	queues         map[string]*sessionQueue
+	heavy          *heavyLimiter // nil is unlimited
+	toolTimeout    time.Duration // tools without their own deadline; 0 is none
+	toolTimeouts   map[string]time.Duration
 }
 
 func New(registry *session.Registry, workers worker.Controller, logger *log.Logger, sessionTimeout time.Duration, debug bool, store *session.Store) *Server {
@@ -62,9 +141,27 @@ func New(registry *session.Registry, workers worker.Controller, logger *log.Logg
 		store:          store,
 		cache:          make(map[string]*sessionCache),
 		progress:       make(map[string]*sessionProgress),
+		history:        make(map[string]*undoHistory),
+		heavy:          newHeavyLimiter(defaultMaxHeavyOperations()),
+	}
+}
+
+// SetEviction sets the policy applied at the session limit and the binaries
+// whose sessions are pinned when opened.
+func (s *Server) SetEviction(policy string, pinnedBinaries []string) {
+	s.eviction = policy
+	s.pinnedBinaries = make(map[string]bool, len(pinnedBinaries))
+	for _, path := range pinnedBinaries {
+		s.pinnedBinaries[filepath.Clean(path)] = true
 	}
 }
 
+// SetStaleBinaryPolicy sets whether calls on a session whose binary changed
+// on disk are refused or only warned about.
+func (s *Server) SetStaleBinaryPolicy(policy string) {
+	s.staleBinary = policy
+}
+
 func GetDefaultDBDir() string {
 	// Linux/macOS: honour XDG_DATA_HOME first, then ~/.local/share
 	if xdgData := os.Getenv("XDG_DATA_HOME"); xdgData != "" {
@@ -140,6 +237,114 @@ func ApplyEnvOverrides(cfg *Config) {
 			cfg.MaxConcurrentSession = n
 		}
 	}
+	if val := os.Getenv("IDA_MCP_AUTO_SAVE_MIN"); val != "" {
+		if mins, err := strconv.Atoi(val); err == nil {
+			cfg.AutoSaveIntervalMin = mins
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_MEMORY_MB"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.WorkerMemoryLimitMB = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_CPU_PERCENT"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.WorkerCPUPercent = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_MIN_FREE_MEMORY_MB"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.MinFreeMemoryMB = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_ADMISSION_WAIT_SEC"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.AdmissionWaitSec = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_POOL"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.WorkerPoolSize = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_LOG_LINES"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.WorkerLogLines = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_LOG_FILES"); val != "" {
+		if parsed, ok := parseBool(val); ok {
+			cfg.WorkerLogFiles = parsed
+		}
+	}
+	if val := os.Getenv("IDA_MCP_WORKER_HOSTS"); val != "" {
+		cfg.WorkerHosts = nil
+		for _, host := range strings.Split(val, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				cfg.WorkerHosts = append(cfg.WorkerHosts, host)
+			}
+		}
+	}
+	if val := os.Getenv("IDA_MCP_TLS_CERT"); val != "" {
+		cfg.TLSCertFile = val
+	}
+	if val := os.Getenv("IDA_MCP_TLS_KEY"); val != "" {
+		cfg.TLSKeyFile = val
+	}
+	if val := os.Getenv("IDA_MCP_TLS_CA"); val != "" {
+		cfg.TLSCAFile = val
+	}
+	if val := os.Getenv("IDA_MCP_SESSION_EVICTION"); val != "" {
+		cfg.SessionEviction = val
+	}
+	if val := os.Getenv("IDA_MCP_PINNED_BINARIES"); val != "" {
+		cfg.PinnedBinaries = nil
+		for _, path := range strings.Split(val, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				cfg.PinnedBinaries = append(cfg.PinnedBinaries, path)
+			}
+		}
+	}
+	if val := os.Getenv("IDA_MCP_STALE_BINARY"); val != "" {
+		cfg.StaleBinary = val
+	}
+	if val := os.Getenv("IDA_MCP_DATABASE_QUOTA_MB"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.DatabaseQuotaMB = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_SESSION_QUOTA_MB"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.SessionQuotaMB = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_SESSION_STORE"); val != "" {
+		cfg.SessionStore = val
+	}
+	if val := os.Getenv("IDA_MCP_TOOL_TIMEOUT_SEC"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.ToolTimeoutSec = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_TOOL_TIMEOUTS"); val != "" {
+		// tool=seconds pairs, e.g. get_decompiled_func=90,find_binary=0
+		for _, pair := range strings.Split(val, ",") {
+			tool, sec, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			n, err := strconv.Atoi(strings.TrimSpace(sec))
+			if !ok || err != nil || tool == "" {
+				continue
+			}
+			if cfg.ToolTimeouts == nil {
+				cfg.ToolTimeouts = make(map[string]int)
+			}
+			cfg.ToolTimeouts[strings.TrimSpace(tool)] = n
+		}
+	}
+	if val := os.Getenv("IDA_MCP_MAX_HEAVY_OPS"); val != "" {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.MaxHeavyOperations = n
+		}
+	}
 	if val := os.Getenv("IDA_MCP_WORKER"); val != "" {
 		cfg.PythonWorkerPath = val
 	}
@@ -150,10 +355,32 @@ func ApplyEnvOverrides(cfg *Config) {
 	}
 }
 
+// NewWorkerManager creates a local worker manager with the configured
+// limits, admission policy and log capture.
+func NewWorkerManager(cfg Config, logger *log.Logger) *worker.Manager {
+	workers := worker.NewManager(cfg.PythonWorkerPath, logger)
+	workers.SetLimits(worker.Limits{
+		MemoryBytes: uint64(cfg.WorkerMemoryLimitMB) << 20,
+		CPUPercent:  cfg.WorkerCPUPercent,
+	})
+	workers.SetAdmission(worker.Admission{
+		MinFreeBytes: uint64(cfg.MinFreeMemoryMB) << 20,
+		Wait:         time.Duration(cfg.AdmissionWaitSec) * time.Second,
+	})
+	logConfig := worker.LogConfig{Lines: cfg.WorkerLogLines}
+	if cfg.WorkerLogFiles {
+		logConfig.Dir = filepath.Join(cfg.DatabaseDirectory, "logs")
+	}
+	workers.SetLogConfig(logConfig)
+	return workers
+}
+
 func (s *Server) RegisterTools(mcpServer *mcp.Server) {
+	mcpServer.AddReceivingMiddleware(s.trackInFlight, s.wakeDormant, s.scheduleCalls, s.limitHeavyCalls, s.propagateCancel, s.enforceTimeouts)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:        "open_binary",
-		Description: "Open binary file for analysis",
+		Description: "Open binary file for analysis, optionally labelling the new session with a label, owner and tags",
 	}, s.openBinary)
 
 	mcp.AddTool(mcpServer, &mcp.Tool{
@@ -163,9 +390,19 @@ func (s *Server) RegisterTools(mcpServer *mcp.Server) {
 
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:        "list_sessions",
-		Description: "List active analysis sessions",
+		Description: "List active analysis sessions, optionally filtered by owner, tags or binary path glob",
 	}, s.listSessions)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "update_session",
+		Description: "Change a session's label, owner or tags",
+	}, s.updateSession)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "keepalive_session",
+		Description: "Reset a session's idle timer, and optionally pin it so the watchdog never closes it",
+	}, s.keepaliveSession)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:        "close_all_sessions",
 		Description: "Close all active analysis sessions",
@@ -176,6 +413,71 @@ func (s *Server) RegisterTools(mcpServer *mcp.Server) {
 		Description: "Save IDA database",
 	}, s.saveDatabase)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "snapshot_database",
+		Description: "Save the database and keep a copy that restore_snapshot can roll back to",
+	}, s.snapshotDatabase)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "list_snapshots",
+		Description: "List a session's database snapshots",
+	}, s.listSnapshots)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "restore_snapshot",
+		Description: "Roll the session's database back to a snapshot, discarding later changes; the worker restarts under the same session ID",
+	}, s.restoreSnapshot)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "fork_session",
+		Description: "Copy a session's database into a new session linked to it, to try risky changes in isolation",
+	}, s.forkSession)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "merge_session_annotations",
+		Description: "Carry names, comments and types at chosen addresses from a forked session back to its parent",
+	}, s.mergeSessionAnnotations)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "replay_annotations",
+		Description: "Re-apply another session's recorded changes, or explicit steps, to this session, anchoring each by address, name or byte pattern; reports applied, unanchored and conflicting steps",
+	}, s.replayAnnotations)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "export_session",
+		Description: "Save a session and write its database and metadata to a single bundle another server can import",
+	}, s.exportSession)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "import_session",
+		Description: "Unpack a bundle written by export_session into the database directory and open it as a new session",
+	}, s.importSession)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "get_change_log",
+		Description: "List the recorded changes made to a session's database, with prior and new values and the client that made them, filtered by address, tool or time range",
+	}, s.getChangeLog)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "undo",
+		Description: "Revert the last changes made to a session (set_name, set_comment, set_function_type, rename_global, ...) by restoring their prior values; stops at a change that cannot be reverted",
+	}, s.undo)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "redo",
+		Description: "Re-apply changes reverted by undo, until another change is made",
+	}, s.redo)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "list_undo_stack",
+		Description: "List the changes undo would revert, most recent first, and those redo would re-apply",
+	}, s.listUndoStack)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "gc_databases",
+		Description: "Delete databases, forks, imports and snapshots left by sessions that no longer exist, and report storage use against the quotas",
+	}, s.gcDatabases)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:        "get_bytes",
 		Description: "Read bytes at address",
@@ -241,6 +543,21 @@ func (s *Server) RegisterTools(mcpServer *mcp.Server) {
 		Description: "Fetch latest server-side progress snapshot for a session",
 	}, s.getSessionProgress)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "get_worker_status",
+		Description: "Report worker liveness, memory, unsaved changes, pending requests and crash history for a session",
+	}, s.getWorkerStatus)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "get_session_queue",
+		Description: "Show the tool calls waiting for a session's worker in the order they will run, the call holding it, and wait times by priority",
+	}, s.getSessionQueue)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:        "get_worker_logs",
+		Description: "Read captured worker output for a session, including output of a crashed worker",
+	}, s.getWorkerLogs)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:        "run_auto_analysis",
 		Description: "Force IDA auto-analysis to finish (plan_and_wait)",
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
 	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
 )
 
 // Session lifecycle management functions
@@ -18,33 +22,130 @@ func (s *Server) RestoreSessions() {
 	if s.store == nil {
 		return
 	}
-	metas, err := s.store.Load()
+	metas, problems, err := s.store.Load()
 	if err != nil {
 		s.logger.Printf("Failed to load persisted sessions: %v", err)
 		return
 	}
+	for _, problem := range problems {
+		s.logger.Printf("Warning: not restoring %v", problem)
+	}
 	if len(metas) == 0 {
 		return
 	}
 
-	s.logger.Printf("Restoring %d session(s) from disk", len(metas))
+	// Workers start lazily, the first time a tool targets the session
+	s.logger.Printf("Restoring %d session(s) from disk as dormant", len(metas))
 	for _, meta := range metas {
 		sess, err := s.registry.Restore(meta)
 		if err != nil {
 			s.logger.Printf("Skipping session %s: %v", meta.ID, err)
 			continue
 		}
-		if err := s.workers.Start(context.Background(), sess, meta.BinaryPath); err != nil {
-			s.logger.Printf("Failed to restart worker for session %s: %v", sess.ID, err)
-			s.registry.Delete(sess.ID)
-			s.deleteSessionState(sess.ID)
-			s.deleteSessionCache(sess.ID)
-			continue
-		}
 		s.logger.Printf("Session %s restored for binary %s", sess.ID, meta.BinaryPath)
 	}
 }
 
+// wakeSession starts the worker of a dormant session. The start is detached
+// from ctx because other callers may be waiting on it.
+func (s *Server) wakeSession(ctx context.Context, sess *session.Session) error {
+	return s.withSessionSlot(func() error {
+		return s.registry.Wake(ctx, sess.ID, func(sess *session.Session) error {
+			s.logger.Printf("Waking dormant session %s for binary %s", sess.ID, sess.BinaryPath)
+			if err := s.workers.Start(context.WithoutCancel(ctx), sess, sess.BinaryPath); err != nil {
+				s.logger.Printf("Failed to start worker for session %s: %v", sess.ID, err)
+				return err
+			}
+			return nil
+		})
+	})
+}
+
+// checkBinary compares the session's binary on disk with the one its
+// database was built from. A changed binary yields a warning, or a ToolError
+// under the refuse policy. current may be passed if the caller already
+// hashed the file; otherwise it is only rehashed when its size or
+// modification time changed.
+func (s *Server) checkBinary(op string, sess *session.Session, current session.FileIdentity) (string, *ToolError) {
+	// Imported sessions open the database itself; the binary is elsewhere
+	if !sess.Identity.Known() || isDatabaseFile(sess.BinaryPath) {
+		return "", nil
+	}
+	if !current.Known() {
+		if sess.Identity.Unchanged(sess.BinaryPath) {
+			return "", nil
+		}
+		var err error
+		if current, err = session.IdentifyFile(sess.BinaryPath); err != nil {
+			s.logger.Printf("Warning: cannot hash %s: %v", sess.BinaryPath, err)
+			return "", nil
+		}
+	}
+	if !sess.Identity.Differs(current) {
+		return "", nil
+	}
+	if s.staleBinary == StaleBinaryRefuse {
+		return "", staleBinary(op, sess, current)
+	}
+	warning := fmt.Sprintf("%s changed on disk since its database was built (sha256 %s, now %s); results describe the old binary",
+		sess.BinaryPath, sess.Identity.SHA256, current.SHA256)
+	s.logger.Printf("[Stale] Session %s: %s", sess.ID, warning)
+	return warning, nil
+}
+
+// hasSession reports whether open_binary would join an existing session
+// rather than create one.
+func (s *Server) hasSession(path string, identity session.FileIdentity) bool {
+	if _, ok := s.registry.FindByBinaryPath(path); ok {
+		return true
+	}
+	if identity.Known() {
+		if _, ok := s.registry.FindBySHA256(identity.SHA256); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withSessionSlot runs acquire and, under the evict_lru policy, evicts idle
+// sessions for as long as it fails at the session limit.
+func (s *Server) withSessionSlot(acquire func() error) error {
+	for {
+		err := acquire()
+		if !errors.Is(err, session.ErrMaxSessions) || s.eviction != EvictionLRU {
+			return err
+		}
+		evicted, evictErr := s.registry.Evict(s.suspendSession)
+		if evictErr != nil {
+			return fmt.Errorf("%w: %w", err, evictErr)
+		}
+		s.logger.Printf("[Eviction] Session %s for %s is dormant to free a slot", evicted.ID, evicted.BinaryPath)
+	}
+}
+
+// suspendSession saves an evicted session's database and stops its worker.
+// A failed save keeps the session active rather than lose its changes.
+func (s *Server) suspendSession(sess *session.Session) error {
+	ctx, cancel := context.WithTimeout(context.Background(), autoSaveTimeout)
+	defer cancel()
+	release, err := s.holdWorker(ctx, sess.ID, "evict_session")
+	if err != nil {
+		return fmt.Errorf("wait for worker: %w", err)
+	}
+	defer release()
+	if _, err := s.saveSession(ctx, sess); err != nil {
+		s.logger.Printf("[Eviction] Session %s not evicted, save failed: %v", sess.ID, err)
+		return fmt.Errorf("save database: %w", err)
+	}
+	if err := s.workers.Stop(sess.ID); err != nil {
+		s.logger.Printf("[Eviction] Failed to stop worker for session %s: %v", sess.ID, err)
+	}
+	s.deleteSessionCache(sess.ID)
+	s.clearProgress(sess.ID)
+	s.persistSession(sess)
+	return nil
+}
+
 func (s *Server) persistSession(sess *session.Session) {
 	if s.store == nil {
 		return
@@ -54,12 +155,16 @@ func (s *Server) persistSession(sess *session.Session) {
 	}
 }
 
-func (s *Server) deleteSessionState(sessionID string) {
+// deleteSessionState removes everything kept on disk for a closed session:
+// its metadata, snapshots and, for a fork or import, its database.
+func (s *Server) deleteSessionState(sess *session.Session) {
+	s.deleteSnapshots(sess.ID)
+	s.deleteOwnedFiles(sess)
 	if s.store == nil {
 		return
 	}
-	if err := s.store.Delete(sessionID); err != nil {
-		s.logger.Printf("Warning: failed to delete session %s: %v", sessionID, err)
+	if err := s.store.Delete(sess.ID); err != nil {
+		s.logger.Printf("Warning: failed to delete session %s: %v", sess.ID, err)
 	}
 }
 
@@ -72,28 +177,241 @@ func (s *Server) Watchdog() {
 		expired := s.registry.Expired()
 		for _, sess := range expired {
 			s.logger.Printf("[Watchdog] Session %s expired, cleaning up", sess.ID)
-			s.workers.Stop(sess.ID)
+			if !sess.Dormant() {
+				s.workers.Stop(sess.ID)
+			}
 			s.registry.Delete(sess.ID)
-			s.deleteSessionState(sess.ID)
+			s.deleteSessionState(sess)
 			s.deleteSessionCache(sess.ID)
 			s.clearProgress(sess.ID)
+			s.clearHistory(sess.ID)
+			s.dropQueue(sess.ID)
+		}
+	}
+}
+
+// AutoSave periodically saves every dirty session. Saves are staggered across
+// the interval so sessions do not all hit their workers at the same moment.
+func (s *Server) AutoSave(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.autoSaveDirtySessions(interval)
+	}
+}
+
+// HandleWorkerRecovery drops state that referred to a crashed worker. Cached
+// listings and progress describe the old process, and unsaved changes did not
+// survive the crash, so the undo stack no longer matches the database.
+func (s *Server) HandleWorkerRecovery(ev worker.RecoveryEvent) {
+	sess, ok := s.registry.Get(ev.SessionID)
+	if !ok {
+		return
+	}
+	s.deleteSessionCache(sess.ID)
+	s.clearProgress(sess.ID)
+	s.clearHistory(sess.ID)
+	if ev.Recovered {
+		s.logger.Printf("[Recovery] Session %s reattached to worker PID %d", sess.ID, sess.WorkerPID)
+		s.persistSession(sess)
+	} else {
+		s.logger.Printf("[Recovery] Session %s left without a worker: %v", sess.ID, ev.Err)
+	}
+}
+
+func (s *Server) autoSaveDirtySessions(window time.Duration) {
+	var dirty []*session.Session
+	for _, sess := range s.registry.List() {
+		if sess.IsDirty() || s.workerReportsDirty(sess) {
+			dirty = append(dirty, sess)
+		}
+	}
+	if len(dirty) == 0 {
+		return
+	}
+	// Oldest save first, so a slow round still reaches the most exposed sessions
+	sort.Slice(dirty, func(i, j int) bool {
+		return dirty[i].SaveStatus().LastSave.Before(dirty[j].SaveStatus().LastSave)
+	})
+
+	gap := window / time.Duration(len(dirty)+1)
+	for i, sess := range dirty {
+		if i > 0 {
+			time.Sleep(gap)
+		}
+		if _, ok := s.registry.Get(sess.ID); !ok {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), autoSaveTimeout)
+		release, err := s.holdWorker(ctx, sess.ID, "auto_save")
+		if err != nil {
+			s.logger.Printf("[AutoSave] Session %s busy, save skipped: %v", sess.ID, err)
+			cancel()
+			continue
+		}
+		if _, err := s.saveSession(ctx, sess); err != nil {
+			s.logger.Printf("[AutoSave] Session %s save failed: %v", sess.ID, err)
+		} else {
+			s.logger.Printf("[AutoSave] Session %s saved", sess.ID)
+		}
+		release()
+		cancel()
+	}
+}
+
+// workerReportsDirty reports whether the session's worker has said since
+// the last save that its database has unsaved changes, which covers changes
+// the server does not count, such as those made by auto-analysis.
+func (s *Server) workerReportsDirty(sess *session.Session) bool {
+	if sess.Dormant() {
+		return false
+	}
+	health := s.workers.Info(sess.ID).Health
+	return health != nil && health.Dirty && health.StatusAt.After(sess.SaveStatus().LastSave)
+}
+
+// saveSession saves the session's database through its worker and records
+// the outcome on the session.
+func (s *Server) saveSession(ctx context.Context, sess *session.Session) (*pb.SaveDatabaseResponse, error) {
+	client, err := s.workers.GetClient(sess.ID)
+	if err != nil {
+		sess.RecordSaveFailure(err)
+		return nil, err
+	}
+	generation := sess.Changes()
+	resp, err := (*client.SessionCtrl).SaveDatabase(ctx, connect.NewRequest(&pb.SaveDatabaseRequest{}))
+	if err != nil {
+		sess.RecordSaveFailure(err)
+		return nil, err
+	}
+	if !resp.Msg.Success {
+		msg := resp.Msg.Error
+		if msg == "" {
+			msg = "save failed"
 		}
+		err := errors.New(msg)
+		sess.RecordSaveFailure(err)
+		return nil, err
+	}
+	// Recorded on the server's clock, which worker statuses are stamped
+	// with too; the worker's own timestamp is in whole seconds and may be
+	// from another host.
+	sess.RecordSave(generation, time.Now())
+	// A session closed while its save was in flight stays deleted
+	if _, ok := s.registry.Get(sess.ID); ok {
+		s.persistSession(sess)
+	}
+	return resp.Msg, nil
+}
+
+// databaseInfo extracts what the worker reported about a database it opened.
+func databaseInfo(resp *pb.OpenBinaryResponse) session.DatabaseInfo {
+	return session.DatabaseInfo{
+		HasDecompiler: resp.HasDecompiler,
+		Processor:     resp.Processor,
+		Bitness:       int(resp.Bitness),
 	}
 }
 
 // MCP tool implementations for session management
 
+// abortOpen abandons a session whose open failed and hands the error to any
+// open_binary calls waiting on it.
+func (s *Server) abortOpen(sess *session.Session, toolErr *ToolError) {
+	s.registry.FinishOpen(sess.ID, toolErr)
+	s.deleteSessionCache(sess.ID)
+	s.clearProgress(sess.ID)
+}
+
+func (s *Server) failOpen(sess *session.Session, toolErr *ToolError) (*mcp.CallToolResult, any, error) {
+	s.abortOpen(sess, toolErr)
+	return s.handleToolError(toolErr)
+}
+
+// validateTags rejects tags that could not be filtered on.
+func validateTags(op string, tags map[string]string) *ToolError {
+	for key := range tags {
+		if strings.TrimSpace(key) == "" {
+			return invalidInput(op, "tag keys must not be empty")
+		}
+	}
+	return nil
+}
+
+func labelsJSON(entry map[string]interface{}, labels session.Labels) {
+	entry["label"] = labels.Label
+	entry["owner"] = labels.Owner
+	tags := labels.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	entry["tags"] = tags
+}
+
 func (s *Server) openBinary(ctx context.Context, req *mcp.CallToolRequest, args OpenBinaryRequest) (*mcp.CallToolResult, any, error) {
 	const op = "open_binary"
-	s.logToolInvocation(op, "", map[string]interface{}{"path": args.Path})
-	if existing, ok := s.registry.FindByBinaryPath(args.Path); ok {
-		s.recordProgress(existing.ID, op, "Session reused", 1, 1)
+	s.logToolInvocation(op, "", map[string]interface{}{"path": args.Path, "label": args.Label, "owner": args.Owner})
+	if toolErr := validateTags(op, args.Tags); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	identity, err := session.IdentifyFile(args.Path)
+	if err != nil {
+		s.logger.Printf("Warning: cannot hash %s: %v", args.Path, err)
+	}
+	dbPath := s.managedDatabasePath(args.Path, identity)
+	if dbPath != "" && !s.hasSession(args.Path, identity) {
+		if toolErr := s.checkStorageQuota(op); toolErr != nil {
+			return s.handleToolError(toolErr)
+		}
+	}
+	var sess *session.Session
+	var created bool
+	err = s.withSessionSlot(func() (err error) {
+		sess, created, err = s.registry.OpenOrJoin(ctx, args.Path, dbPath, identity, s.sessionTimeout)
+		return err
+	})
+	if err != nil {
+		// A concurrent open of the same binary failed; report its error
+		var toolErr *ToolError
+		if errors.As(err, &toolErr) {
+			return s.handleToolError(toolErr)
+		}
+		if errors.Is(err, session.ErrMaxSessions) {
+			return s.handleToolError(sessionLimitReached(op, err))
+		}
+		return s.handleToolError(internalError(op, err))
+	}
+	if !created {
+		warning, toolErr := s.checkBinary(op, sess, identity)
+		if toolErr != nil {
+			return s.handleToolError(toolErr)
+		}
+		if sess.Dormant() {
+			if err := s.wakeSession(ctx, sess); err != nil {
+				return s.handleToolError(workerUnavailable(op, sess.ID, err))
+			}
+		}
+		s.recordProgress(sess.ID, op, "Session reused", 1, 1)
 		result := map[string]interface{}{
-			"session_id":     existing.ID,
-			"binary_path":    existing.BinaryPath,
-			"has_decompiler": true,
-			"created_at":     existing.CreatedAt.Unix(),
+			"session_id":     sess.ID,
+			"binary_path":    sess.BinaryPath,
+			"has_decompiler": sess.DatabaseInfo().HasDecompiler,
+			"created_at":     sess.CreatedAt.Unix(),
 			"reused":         true,
+			"sha256":         sess.Identity.SHA256,
+		}
+		// A reused session keeps its labels; update_session changes them
+		labelsJSON(result, sess.Labels())
+		if sess.BinaryPath != filepath.Clean(args.Path) {
+			// An identical binary at another path
+			result["requested_path"] = args.Path
+		}
+		if warning != "" {
+			result["warning"] = warning
 		}
 		jsonResult, _ := s.marshalJSON(result)
 		return &mcp.CallToolResult{
@@ -103,10 +421,13 @@ func (s *Server) openBinary(ctx context.Context, req *mcp.CallToolRequest, args
 		}, nil, nil
 	}
 
-	sess, err := s.registry.Create(args.Path, s.sessionTimeout)
-	if err != nil {
-		return s.handleToolError(internalError(op, err))
+	// The new session is busy until the open completes
+	defer sess.Begin()()
+	sess.SetLabels(session.Labels{Label: args.Label, Owner: args.Owner, Tags: args.Tags})
+	if s.pinnedBinaries[filepath.Clean(args.Path)] {
+		sess.SetPinned(true)
 	}
+	s.seedDatabase(sess)
 	progress := s.progressReporter(ctx, req, sess.ID, op)
 	const totalSteps = 5.0
 	currentStep := 0.0
@@ -115,10 +436,7 @@ func (s *Server) openBinary(ctx context.Context, req *mcp.CallToolRequest, args
 	s.emitProgress(progress, sess.ID, op, "Starting Python worker", currentStep, totalSteps)
 
 	if err := s.workers.Start(ctx, sess, args.Path); err != nil {
-		s.registry.Delete(sess.ID)
-		s.deleteSessionCache(sess.ID)
-		s.clearProgress(sess.ID)
-		return s.handleToolError(workerUnavailable(op, sess.ID, err))
+		return s.failOpen(sess, workerUnavailable(op, sess.ID, err))
 	}
 	currentStep++
 	s.emitProgress(progress, sess.ID, op, "Connecting to worker", currentStep, totalSteps)
@@ -126,32 +444,24 @@ func (s *Server) openBinary(ctx context.Context, req *mcp.CallToolRequest, args
 	client, err := s.workers.GetClient(sess.ID)
 	if err != nil {
 		s.workers.Stop(sess.ID)
-		s.registry.Delete(sess.ID)
-		s.deleteSessionCache(sess.ID)
-		s.clearProgress(sess.ID)
-		return s.handleToolError(workerUnavailable(op, sess.ID, err))
+		return s.failOpen(sess, workerUnavailable(op, sess.ID, err))
 	}
 	currentStep++
 	s.emitProgress(progress, sess.ID, op, "Opening binary in IDA", currentStep, totalSteps)
 
 	resp, err := (*client.SessionCtrl).OpenBinary(ctx, connect.NewRequest(&pb.OpenBinaryRequest{
-		BinaryPath:  args.Path,
-		AutoAnalyze: false,
+		BinaryPath:   args.Path,
+		AutoAnalyze:  false,
+		DatabasePath: sess.DatabasePath,
 	}))
 	if err != nil {
 		s.workers.Stop(sess.ID)
-		s.registry.Delete(sess.ID)
-		s.deleteSessionCache(sess.ID)
-		s.clearProgress(sess.ID)
-		return s.handleToolError(idaOperationFailed(op, sess.ID, err))
+		return s.failOpen(sess, idaOperationFailed(op, sess.ID, err))
 	}
 
 	if !resp.Msg.Success {
 		s.workers.Stop(sess.ID)
-		s.registry.Delete(sess.ID)
-		s.deleteSessionCache(sess.ID)
-		s.clearProgress(sess.ID)
-		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(resp.Msg.Error)))
+		return s.failOpen(sess, idaOperationFailed(op, sess.ID, errors.New(resp.Msg.Error)))
 	}
 
 	var autoState string
@@ -161,17 +471,24 @@ func (s *Server) openBinary(ctx context.Context, req *mcp.CallToolRequest, args
 		autoRunning = infoResp.Msg.GetAutoRunning()
 	}
 
+	sess.SetDatabaseInfo(databaseInfo(resp.Msg))
 	s.persistSession(sess)
+	s.registry.FinishOpen(sess.ID, nil)
 	s.emitProgress(progress, sess.ID, "ready", "Session ready", totalSteps, totalSteps)
 
 	result := map[string]interface{}{
 		"session_id":     sess.ID,
 		"binary_path":    args.Path,
 		"has_decompiler": resp.Msg.HasDecompiler,
+		"processor":      resp.Msg.Processor,
+		"bitness":        resp.Msg.Bitness,
 		"created_at":     sess.CreatedAt.Unix(),
+		"sha256":         sess.Identity.SHA256,
+		"database_path":  sess.DatabasePath,
 		"auto_state":     autoState,
 		"auto_running":   autoRunning,
 	}
+	labelsJSON(result, sess.Labels())
 	if autoRunning {
 		result["analysis_tip"] = "Auto-analysis is still running. Call run_auto_analysis to block until completion."
 	} else {
@@ -194,14 +511,18 @@ func (s *Server) closeBinary(ctx context.Context, req *mcp.CallToolRequest, args
 		return s.handleToolError(sessionNotFound(op, args.SessionID))
 	}
 
-	if err := s.workers.Stop(sess.ID); err != nil {
-		return s.handleToolError(workerUnavailable(op, sess.ID, err))
+	if !sess.Dormant() {
+		if err := s.workers.Stop(sess.ID); err != nil {
+			return s.handleToolError(workerUnavailable(op, sess.ID, err))
+		}
 	}
 
 	s.registry.Delete(sess.ID)
-	s.deleteSessionState(sess.ID)
+	s.deleteSessionState(sess)
 	s.deleteSessionCache(sess.ID)
 	s.clearProgress(sess.ID)
+	s.clearHistory(sess.ID)
+	s.dropQueue(sess.ID)
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
@@ -210,20 +531,24 @@ func (s *Server) closeBinary(ctx context.Context, req *mcp.CallToolRequest, args
 	}, nil, nil
 }
 
-func (s *Server) closeAllSessions(ctx context.Context, req *mcp.CallToolRequest, args ListSessionsRequest) (*mcp.CallToolResult, any, error) {
+func (s *Server) closeAllSessions(ctx context.Context, req *mcp.CallToolRequest, args CloseAllSessionsRequest) (*mcp.CallToolResult, any, error) {
 	const op = "close_all_sessions"
 	s.logToolInvocation(op, "", nil)
 	sessions := s.registry.List()
 	closed := 0
 	var errs []string
 	for _, sess := range sessions {
-		if err := s.workers.Stop(sess.ID); err != nil {
-			errs = append(errs, fmt.Sprintf("session %s: %v", sess.ID, err))
+		if !sess.Dormant() {
+			if err := s.workers.Stop(sess.ID); err != nil {
+				errs = append(errs, fmt.Sprintf("session %s: %v", sess.ID, err))
+			}
 		}
 		s.registry.Delete(sess.ID)
-		s.deleteSessionState(sess.ID)
+		s.deleteSessionState(sess)
 		s.deleteSessionCache(sess.ID)
 		s.clearProgress(sess.ID)
+		s.clearHistory(sess.ID)
+		s.dropQueue(sess.ID)
 		closed++
 	}
 	result, _ := s.marshalJSON(map[string]any{
@@ -238,23 +563,71 @@ func (s *Server) closeAllSessions(ctx context.Context, req *mcp.CallToolRequest,
 }
 
 func (s *Server) listSessions(ctx context.Context, req *mcp.CallToolRequest, args ListSessionsRequest) (*mcp.CallToolResult, any, error) {
+	const op = "list_sessions"
+	filter := session.Filter{Owner: args.Owner, Tags: args.Tags, PathGlob: args.PathGlob}
+	if err := filter.Validate(); err != nil {
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("invalid path_glob %q: %v", args.PathGlob, err)))
+	}
 	sessions := s.registry.List()
 
 	result := make([]map[string]interface{}, 0, len(sessions))
+	dormant := make([]map[string]interface{}, 0)
 	for _, sess := range sessions {
-		result = append(result, map[string]interface{}{
+		if !filter.Matches(sess) {
+			continue
+		}
+		if sess.Dormant() {
+			// Restored from disk; the worker starts on first use
+			meta := sess.Metadata()
+			entry := map[string]interface{}{
+				"session_id":    meta.ID,
+				"binary_path":   meta.BinaryPath,
+				"sha256":        meta.SHA256,
+				"parent_id":     meta.ParentID,
+				"database_path": meta.DatabasePath,
+				"created_at":    meta.CreatedAt.Unix(),
+				"last_activity": meta.LastActivity.Unix(),
+				"pinned":        meta.Pinned,
+			}
+			labelsJSON(entry, sess.Labels())
+			dormant = append(dormant, entry)
+			continue
+		}
+		save := sess.SaveStatus()
+		var lastSaved int64
+		if !save.LastSave.IsZero() {
+			lastSaved = save.LastSave.Unix()
+		}
+		entry := map[string]interface{}{
 			"session_id":    sess.ID,
 			"binary_path":   sess.BinaryPath,
+			"sha256":        sess.Identity.SHA256,
+			"parent_id":     sess.ParentID,
+			"database_path": sess.DatabasePath,
 			"created_at":    sess.CreatedAt.Unix(),
 			"last_activity": sess.LastActivity.Unix(),
 			"age_seconds":   time.Since(sess.CreatedAt).Seconds(),
 			"idle_seconds":  time.Since(sess.LastActivity).Seconds(),
-		})
+			"dirty":         save.Dirty,
+			"last_saved_at": lastSaved,
+			"save_failures": save.SaveFailures,
+			"in_flight":     sess.InFlight(),
+			"pinned":        sess.Pinned(),
+		}
+		if save.LastError != "" {
+			entry["last_save_error"] = save.LastError
+		}
+		labelsJSON(entry, sess.Labels())
+		entry["worker"] = s.workers.Info(sess.ID)
+		result = append(result, entry)
 	}
 
 	jsonResult, _ := s.marshalJSON(map[string]interface{}{
-		"sessions": result,
-		"count":    len(result),
+		"sessions":         result,
+		"count":            len(result),
+		"dormant_sessions": dormant,
+		"dormant_count":    len(dormant),
+		"worker_pool":      s.workers.PoolStats(),
 	})
 
 	return &mcp.CallToolResult{
@@ -264,6 +637,80 @@ func (s *Server) listSessions(ctx context.Context, req *mcp.CallToolRequest, arg
 	}, nil, nil
 }
 
+func (s *Server) keepaliveSession(ctx context.Context, req *mcp.CallToolRequest, args KeepaliveSessionRequest) (*mcp.CallToolResult, any, error) {
+	const op = "keepalive_session"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"pin": args.Pin})
+
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+
+	sess.Touch()
+	if args.Pin != nil && *args.Pin != sess.Pinned() {
+		sess.SetPinned(*args.Pin)
+		s.persistSession(sess)
+	}
+
+	result := map[string]interface{}{
+		"session_id":   sess.ID,
+		"pinned":       sess.Pinned(),
+		"in_flight":    sess.InFlight(),
+		"idle_timeout": sess.Timeout.Seconds(),
+	}
+	if !sess.Pinned() {
+		result["expires_at"] = time.Now().Add(sess.Timeout).Unix()
+	}
+	jsonResult, _ := s.marshalJSON(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) updateSession(ctx context.Context, req *mcp.CallToolRequest, args UpdateSessionRequest) (*mcp.CallToolResult, any, error) {
+	const op = "update_session"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"label": args.Label, "owner": args.Owner, "tags": args.Tags})
+	if toolErr := validateTags(op, args.Tags); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	labels := sess.Labels()
+	if args.Label != nil {
+		labels.Label = *args.Label
+	}
+	if args.Owner != nil {
+		labels.Owner = *args.Owner
+	}
+	for key, value := range args.Tags {
+		if value == "" {
+			delete(labels.Tags, key)
+			continue
+		}
+		if labels.Tags == nil {
+			labels.Tags = make(map[string]string)
+		}
+		labels.Tags[key] = value
+	}
+	sess.SetLabels(labels)
+	s.persistSession(sess)
+
+	result := map[string]interface{}{"session_id": sess.ID}
+	labelsJSON(result, labels)
+	jsonResult, _ := s.marshalJSON(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
 func (s *Server) saveDatabase(ctx context.Context, req *mcp.CallToolRequest, args SaveDatabaseRequest) (*mcp.CallToolResult, any, error) {
 	const op = "save_database"
 	sess, ok := s.registry.Get(args.SessionID)
@@ -273,20 +720,19 @@ func (s *Server) saveDatabase(ctx context.Context, req *mcp.CallToolRequest, arg
 
 	sess.Touch()
 
-	client, err := s.workers.GetClient(sess.ID)
-	if err != nil {
+	if _, err := s.workers.GetClient(sess.ID); err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
 
-	resp, err := (*client.SessionCtrl).SaveDatabase(ctx, connect.NewRequest(&pb.SaveDatabaseRequest{}))
+	resp, err := s.saveSession(ctx, sess)
 	if err != nil {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, err))
 	}
 
 	result, _ := s.marshalJSON(map[string]interface{}{
-		"success":   resp.Msg.Success,
-		"timestamp": resp.Msg.Timestamp,
-		"dirty":     resp.Msg.Dirty,
+		"success":   resp.Success,
+		"timestamp": resp.Timestamp,
+		"dirty":     resp.Dirty,
 	})
 
 	return &mcp.CallToolResult{
@@ -454,6 +900,10 @@ loop:
 
 	s.emitProgress(progress, sess.ID, "auto_analysis", "Auto-analysis complete", 1, 1)
 
+	if planResp != nil && planResp.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, 0, args, nil, "")
+	}
 	s.deleteSessionCache(sess.ID)
 
 	resultPayload := map[string]interface{}{
@@ -581,3 +1031,80 @@ func (s *Server) watchAutoAnalysis(ctx context.Context, req *mcp.CallToolRequest
 		},
 	}, nil, nil
 }
+
+func (s *Server) getWorkerStatus(ctx context.Context, req *mcp.CallToolRequest, args GetWorkerStatusRequest) (*mcp.CallToolResult, any, error) {
+	const op = "get_worker_status"
+	s.logToolInvocation(op, args.SessionID, nil)
+
+	if args.SessionID == "" {
+		return s.handleToolError(invalidInput(op, "session_id is required"))
+	}
+
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+
+	jsonResult, _ := s.marshalJSON(map[string]interface{}{
+		"session_id":  sess.ID,
+		"binary_path": sess.BinaryPath,
+		"worker":      s.workers.Info(sess.ID),
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+const defaultLogTail = 100
+
+func (s *Server) getWorkerLogs(ctx context.Context, req *mcp.CallToolRequest, args GetWorkerLogsRequest) (*mcp.CallToolResult, any, error) {
+	const op = "get_worker_logs"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{
+		"tail":  args.Tail,
+		"level": args.Level,
+		"since": args.Since,
+	})
+
+	if args.SessionID == "" {
+		return s.handleToolError(invalidInput(op, "session_id is required"))
+	}
+	if args.Tail < 0 {
+		return s.handleToolError(invalidInput(op, "tail must be non-negative"))
+	}
+	level := strings.ToUpper(args.Level)
+	switch level {
+	case "", "DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL":
+	default:
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("unknown level %q", args.Level)))
+	}
+
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+
+	query := worker.LogQuery{Tail: args.Tail, MinLevel: level}
+	if query.Tail == 0 {
+		query.Tail = defaultLogTail
+	}
+	if args.Since > 0 {
+		query.Since = time.Unix(args.Since, 0)
+	}
+	entries := s.workers.Logs(sess.ID, query)
+	if entries == nil {
+		entries = []worker.LogEntry{}
+	}
+
+	jsonResult, _ := s.marshalJSON(map[string]interface{}{
+		"session_id": sess.ID,
+		"count":      len(entries),
+		"lines":      entries,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
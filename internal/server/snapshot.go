@@ -0,0 +1,263 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// idaComponentExts are the unpacked database files IDA leaves next to the
+// binary while a database is open or after a crash.
+var idaComponentExts = []string{".id0", ".id1", ".id2", ".nam", ".til"}
+
+// SetDatabaseDirectory sets where snapshots and forked databases are kept.
+func (s *Server) SetDatabaseDirectory(dir string) {
+	s.databaseDir = dir
+}
+
+// databasePath returns the IDA database idalib keeps next to binaryPath.
+func databasePath(binaryPath string) (string, error) {
+	if isDatabaseFile(binaryPath) {
+		return binaryPath, nil
+	}
+	stem := strings.TrimSuffix(binaryPath, filepath.Ext(binaryPath))
+	for _, candidate := range []string{binaryPath + ".i64", stem + ".i64", binaryPath + ".idb", stem + ".idb"} {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no IDA database found for %s", binaryPath)
+}
+
+// isDatabaseFile reports whether path names an IDA database rather than a
+// binary.
+func isDatabaseFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".i64", ".idb":
+		return true
+	}
+	return false
+}
+
+// copyFile copies src to dst through a temporary file, so dst is either the
+// old or the complete new file. It returns the number of bytes copied.
+func copyFile(src, dst string) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return n, nil
+}
+
+// checkLocalDatabase refuses tools that copy database files when the
+// database lives on a remote worker host.
+func (s *Server) checkLocalDatabase(op string) *ToolError {
+	if !s.workers.LocalFilesystem() {
+		return invalidInput(op, op+" needs local workers; the database lives on the worker host")
+	}
+	if s.databaseDir == "" {
+		return invalidInput(op, "database_directory is not configured")
+	}
+	return nil
+}
+
+func (s *Server) snapshotDir(sessionID string) string {
+	return filepath.Join(s.databaseDir, storageSnapshots, sessionID)
+}
+
+func (s *Server) deleteSnapshots(sessionID string) {
+	if s.databaseDir == "" {
+		return
+	}
+	if err := os.RemoveAll(s.snapshotDir(sessionID)); err != nil {
+		s.logger.Printf("Warning: failed to delete snapshots of session %s: %v", sessionID, err)
+	}
+}
+
+func snapshotJSON(snap session.Snapshot) map[string]any {
+	return map[string]any{
+		"snapshot_id": snap.ID,
+		"label":       snap.Label,
+		"created_at":  snap.CreatedAt.Unix(),
+		"size":        snap.Size,
+	}
+}
+
+func (s *Server) snapshotDatabase(ctx context.Context, req *mcp.CallToolRequest, args SnapshotDatabaseRequest) (*mcp.CallToolResult, any, error) {
+	const op = "snapshot_database"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"label": args.Label})
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	if _, err := s.workers.GetClient(sess.ID); err != nil {
+		return s.handleToolError(workerUnavailable(op, sess.ID, err))
+	}
+	if toolErr := s.checkStorageQuota(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	if toolErr := s.checkSessionQuota(op, sess); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	if _, err := s.saveSession(ctx, sess); err != nil {
+		return s.handleToolError(idaOperationFailed(op, sess.ID, fmt.Errorf("save database: %w", err)))
+	}
+	dbPath, err := sessionDatabase(sess)
+	if err != nil {
+		return s.handleToolError(idaOperationFailed(op, sess.ID, err))
+	}
+
+	// Each snapshot gets its own numbered directory; the file keeps its name
+	snap := sess.AddSnapshot(session.Snapshot{Label: args.Label, CreatedAt: time.Now()})
+	dir := filepath.Join(s.snapshotDir(sess.ID), fmt.Sprintf("%04d", snap.ID))
+	path := filepath.Join(dir, filepath.Base(dbPath))
+	err = os.MkdirAll(dir, 0o755)
+	if err == nil {
+		snap.Size, err = copyFile(dbPath, path)
+	}
+	if err != nil {
+		sess.DeleteSnapshot(snap.ID)
+		os.RemoveAll(dir)
+		return s.handleToolError(internalError(op, fmt.Errorf("copy database: %w", err)))
+	}
+	snap.Path = path
+	sess.UpdateSnapshot(snap)
+	s.persistSession(sess)
+	s.logger.Printf("[Snapshot] Session %s snapshot %d (%d bytes) at %s", sess.ID, snap.ID, snap.Size, snap.Path)
+
+	jsonResult, _ := s.marshalJSON(snapshotJSON(snap))
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) listSnapshots(ctx context.Context, req *mcp.CallToolRequest, args ListSnapshotsRequest) (*mcp.CallToolResult, any, error) {
+	const op = "list_snapshots"
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	snapshots := make([]map[string]any, 0)
+	for _, snap := range sess.Snapshots() {
+		if snap.Path == "" {
+			// Still being copied
+			continue
+		}
+		snapshots = append(snapshots, snapshotJSON(snap))
+	}
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id": sess.ID,
+		"snapshots":  snapshots,
+		"count":      len(snapshots),
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) restoreSnapshot(ctx context.Context, req *mcp.CallToolRequest, args RestoreSnapshotRequest) (*mcp.CallToolResult, any, error) {
+	const op = "restore_snapshot"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"snapshot_id": args.SnapshotID})
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	snap, ok := sess.Snapshot(args.SnapshotID)
+	if !ok || snap.Path == "" {
+		return s.handleToolError(invalidInput(op, fmt.Sprintf("session %s has no snapshot %d", sess.ID, args.SnapshotID)))
+	}
+	if _, err := os.Stat(snap.Path); err != nil {
+		return s.handleToolError(idaOperationFailed(op, sess.ID, fmt.Errorf("snapshot file: %w", err)))
+	}
+	sess.Touch()
+
+	// Stop the worker, swap the file, then start a worker under the same ID.
+	// Calls arriving meanwhile wait for the swap.
+	var wasActive bool
+	err := s.registry.Suspend(sess.ID, func(sess *session.Session, active bool) error {
+		wasActive = active
+		if active {
+			if err := s.workers.Stop(sess.ID); err != nil {
+				s.logger.Printf("[Snapshot] Failed to stop worker for session %s: %v", sess.ID, err)
+			}
+		}
+		s.deleteSessionCache(sess.ID)
+		s.clearProgress(sess.ID)
+		s.clearHistory(sess.ID)
+
+		target := sess.DatabasePath
+		if target == "" {
+			target = filepath.Join(filepath.Dir(sess.BinaryPath), filepath.Base(snap.Path))
+		}
+		// Leftover unpacked files would take precedence over the restored file
+		stem := strings.TrimSuffix(target, filepath.Ext(target))
+		for _, ext := range idaComponentExts {
+			if err := os.Remove(stem + ext); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+		_, err := copyFile(snap.Path, target)
+		return err
+	})
+	if err != nil {
+		return s.handleToolError(idaOperationFailed(op, sess.ID, fmt.Errorf("restore database: %w", err)))
+	}
+	// Changes made after the snapshot are gone
+	sess.RecordSave(sess.Changes(), time.Now())
+	if wasActive {
+		if err := s.wakeSession(ctx, sess); err != nil {
+			return s.handleToolError(workerUnavailable(op, sess.ID, err))
+		}
+	}
+	s.logger.Printf("[Snapshot] Session %s restored snapshot %d", sess.ID, snap.ID)
+
+	result := snapshotJSON(snap)
+	result["session_id"] = sess.ID
+	result["restored"] = true
+	jsonResult, _ := s.marshalJSON(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
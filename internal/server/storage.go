@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// Per-session directories kept under the database directory
+const (
+	storageDatabases = "databases" // by SHA-256 of the binary
+	storageForks     = "forks"
+	storageImports   = "imports"
+	storageSnapshots = "snapshots" // by session ID
+)
+
+var storageKinds = []string{storageDatabases, storageForks, storageImports, storageSnapshots}
+
+// gcGracePeriod protects directories a fork or import is still filling
+// before its session is registered.
+const gcGracePeriod = 10 * time.Minute
+
+// SetStorageQuotas limits the bytes kept under the database directory, in
+// total and per session. 0 means unlimited.
+func (s *Server) SetStorageQuotas(totalMB, sessionMB int) {
+	s.databaseQuota = int64(totalMB) << 20
+	s.sessionQuota = int64(sessionMB) << 20
+}
+
+// managedDatabasePath returns where a new session for binaryPath keeps its
+// database: a directory named after the binary's SHA-256, so the input is
+// never written to. It is empty when the server cannot place the database
+// and idalib keeps it next to the binary: with remote workers, without a
+// database directory or when the binary could not be hashed.
+func (s *Server) managedDatabasePath(binaryPath string, identity session.FileIdentity) string {
+	if !s.workers.LocalFilesystem() || s.databaseDir == "" || !identity.Known() {
+		return ""
+	}
+	return filepath.Join(s.databaseDir, storageDatabases, identity.SHA256, filepath.Base(binaryPath)+".i64")
+}
+
+// seedDatabase copies a database idalib left next to the binary before the
+// server placed databases, so earlier analysis carries over.
+func (s *Server) seedDatabase(sess *session.Session) {
+	if sess.DatabasePath == "" {
+		return
+	}
+	if _, err := os.Stat(sess.DatabasePath); err == nil {
+		return
+	}
+	legacy, err := databasePath(sess.BinaryPath)
+	if err != nil || filepath.Ext(legacy) != filepath.Ext(sess.DatabasePath) {
+		return
+	}
+	err = os.MkdirAll(filepath.Dir(sess.DatabasePath), 0o755)
+	if err == nil {
+		_, err = copyFile(legacy, sess.DatabasePath)
+	}
+	if err != nil {
+		s.logger.Printf("Warning: failed to carry over %s for session %s: %v", legacy, sess.ID, err)
+		return
+	}
+	s.logger.Printf("Session %s: carried over existing database %s", sess.ID, legacy)
+}
+
+// sessionDatabase returns the session's database file.
+func sessionDatabase(sess *session.Session) (string, error) {
+	if sess.DatabasePath == "" {
+		return databasePath(sess.BinaryPath)
+	}
+	if _, err := os.Stat(sess.DatabasePath); err != nil {
+		return "", err
+	}
+	return sess.DatabasePath, nil
+}
+
+// storageEntry is one per-session directory under the database directory.
+type storageEntry struct {
+	Path      string
+	Kind      string
+	Size      int64
+	ModTime   time.Time
+	SessionID string // empty once the session is gone
+}
+
+func dirSize(root string) int64 {
+	var size int64
+	filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
+
+// storageEntries lists the per-session directories under the database
+// directory and the open session each belongs to.
+func (s *Server) storageEntries() []storageEntry {
+	if s.databaseDir == "" {
+		return nil
+	}
+	owners := make(map[string]string)
+	for _, sess := range s.registry.List() {
+		if sess.DatabasePath != "" {
+			owners[filepath.Dir(sess.DatabasePath)] = sess.ID
+		}
+		if dir := s.ownedDir(sess); dir != "" {
+			owners[dir] = sess.ID
+		}
+		owners[s.snapshotDir(sess.ID)] = sess.ID
+	}
+
+	var entries []storageEntry
+	for _, kind := range storageKinds {
+		dirents, err := os.ReadDir(filepath.Join(s.databaseDir, kind))
+		if err != nil {
+			continue
+		}
+		for _, d := range dirents {
+			if !d.IsDir() {
+				continue
+			}
+			path := filepath.Join(s.databaseDir, kind, d.Name())
+			entry := storageEntry{Path: path, Kind: kind, Size: dirSize(path), SessionID: owners[path]}
+			if info, err := d.Info(); err == nil {
+				entry.ModTime = info.ModTime()
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func storageUsage(entries []storageEntry) (total int64, bySession map[string]int64) {
+	bySession = make(map[string]int64)
+	for _, entry := range entries {
+		total += entry.Size
+		if entry.SessionID != "" {
+			bySession[entry.SessionID] += entry.Size
+		}
+	}
+	return total, bySession
+}
+
+// collectGarbage removes the directories of sessions that no longer exist
+// and returns what it removed, or would remove when dryRun is set. A closed
+// binary's database is kept for its next open unless databases is set.
+func (s *Server) collectGarbage(dryRun, databases bool) ([]storageEntry, error) {
+	var removed []storageEntry
+	var errs []error
+	for _, entry := range s.storageEntries() {
+		if entry.SessionID != "" || time.Since(entry.ModTime) < gcGracePeriod {
+			continue
+		}
+		if entry.Kind == storageDatabases && !databases {
+			continue
+		}
+		if !dryRun {
+			if err := os.RemoveAll(entry.Path); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			s.logger.Printf("[GC] Removed %s (%d bytes)", entry.Path, entry.Size)
+		}
+		removed = append(removed, entry)
+	}
+	return removed, errors.Join(errs...)
+}
+
+// PruneDatabases removes the forks, imports and snapshots of sessions that
+// no longer exist. It runs at startup, after persisted sessions are
+// restored. Databases are only removed by gc_databases.
+func (s *Server) PruneDatabases() {
+	removed, err := s.collectGarbage(false, false)
+	if err != nil {
+		s.logger.Printf("[GC] Failed to remove some orphaned directories: %v", err)
+	}
+	if len(removed) > 0 {
+		s.logger.Printf("[GC] Removed %d orphaned directories", len(removed))
+	}
+}
+
+// checkStorageQuota refuses to place another database while storage is
+// over database_quota_mb. Orphaned forks, imports and snapshots are
+// collected first.
+func (s *Server) checkStorageQuota(op string) *ToolError {
+	if s.databaseQuota <= 0 {
+		return nil
+	}
+	used, _ := storageUsage(s.storageEntries())
+	if used < s.databaseQuota {
+		return nil
+	}
+	if _, err := s.collectGarbage(false, false); err != nil {
+		s.logger.Printf("[GC] %v", err)
+	}
+	if used, _ = storageUsage(s.storageEntries()); used < s.databaseQuota {
+		return nil
+	}
+	return storageQuotaExceeded(op, "", used, s.databaseQuota)
+}
+
+// checkSessionQuota refuses to grow a session whose database and snapshots
+// are over session_quota_mb.
+func (s *Server) checkSessionQuota(op string, sess *session.Session) *ToolError {
+	if s.sessionQuota <= 0 {
+		return nil
+	}
+	_, bySession := storageUsage(s.storageEntries())
+	if used := bySession[sess.ID]; used >= s.sessionQuota {
+		return storageQuotaExceeded(op, sess.ID, used, s.sessionQuota)
+	}
+	return nil
+}
+
+func (s *Server) gcDatabases(ctx context.Context, req *mcp.CallToolRequest, args GCDatabasesRequest) (*mcp.CallToolResult, any, error) {
+	const op = "gc_databases"
+	s.logToolInvocation(op, "", map[string]interface{}{"dry_run": args.DryRun})
+	if toolErr := s.checkLocalDatabase(op); toolErr != nil {
+		return s.handleToolError(toolErr)
+	}
+
+	removed, err := s.collectGarbage(args.DryRun, true)
+	var freed int64
+	removedJSON := make([]map[string]any, 0, len(removed))
+	for _, entry := range removed {
+		freed += entry.Size
+		removedJSON = append(removedJSON, map[string]any{
+			"path": entry.Path,
+			"kind": entry.Kind,
+			"size": entry.Size,
+		})
+	}
+
+	used, bySession := storageUsage(s.storageEntries())
+	overQuota := make([]map[string]any, 0)
+	if s.sessionQuota > 0 {
+		for id, size := range bySession {
+			if size >= s.sessionQuota {
+				overQuota = append(overQuota, map[string]any{"session_id": id, "used_bytes": size})
+			}
+		}
+		sort.Slice(overQuota, func(i, j int) bool {
+			return overQuota[i]["used_bytes"].(int64) > overQuota[j]["used_bytes"].(int64)
+		})
+	}
+
+	result := map[string]any{
+		"dry_run":             args.DryRun,
+		"removed":             removedJSON,
+		"freed_bytes":         freed,
+		"used_bytes":          used,
+		"quota_bytes":         s.databaseQuota,
+		"session_quota_bytes": s.sessionQuota,
+		"sessions_over_quota": overQuota,
+	}
+	if err != nil {
+		result["errors"] = err.Error()
+	}
+	jsonResult, _ := s.marshalJSON(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
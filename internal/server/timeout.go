@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
+)
+
+// defaultToolTimeouts are the deadlines in seconds of tools known to hang
+// the worker, and of tools that must run unbounded even when
+// tool_timeout_seconds is set. tool_timeouts overrides them; 0 is no
+// deadline. The database copies run on the server, where a slow disk is no
+// sign of a stuck worker.
+var defaultToolTimeouts = map[string]int{
+	"get_decompiled_func": 60,
+	"find_binary":         120,
+	"find_text":           120,
+	"open_binary":         0,
+	"run_auto_analysis":   0,
+	"watch_auto_analysis": 0,
+	"import_il2cpp":       0,
+	"import_flutter":      0,
+	"snapshot_database":   0,
+	"restore_snapshot":    0,
+	"fork_session":        0,
+	"export_session":      0,
+}
+
+// timeoutRetryAfter is roughly how long a killed worker takes to come back.
+const timeoutRetryAfter = 5 * time.Second
+
+// deadlineSlack covers a worker that fails a call on its deadline a moment
+// before it expires here: Connect passes the deadline to the worker in
+// whole milliseconds.
+const deadlineSlack = 50 * time.Millisecond
+
+// SetToolTimeouts sets the deadline of each tool call: perTool seconds by
+// tool name over the built-in defaults, and defaultSec for other tools. 0
+// means no deadline.
+func (s *Server) SetToolTimeouts(defaultSec int, perTool map[string]int) {
+	s.toolTimeout = time.Duration(defaultSec) * time.Second
+	s.toolTimeouts = make(map[string]time.Duration, len(defaultToolTimeouts)+len(perTool))
+	for tool, sec := range defaultToolTimeouts {
+		s.toolTimeouts[tool] = time.Duration(sec) * time.Second
+	}
+	for tool, sec := range perTool {
+		s.toolTimeouts[tool] = time.Duration(sec) * time.Second
+	}
+}
+
+func (s *Server) timeoutFor(tool string) time.Duration {
+	if timeout, ok := s.toolTimeouts[tool]; ok {
+		return timeout
+	}
+	return s.toolTimeout
+}
+
+// enforceTimeouts runs each tool call under its deadline. A worker only
+// abandons a call at the safe points of its long loops, so when a call on a
+// session times out it is cancelled first; a worker still busy with it would
+// stall every later call, so it is killed and restarted from its last save.
+func (s *Server) enforceTimeouts(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		tool, sess := s.callSession(method, req)
+		timeout := s.timeoutFor(tool)
+		if tool == "" || timeout <= 0 {
+			return next(ctx, method, req)
+		}
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		result, err := next(callCtx, method, req)
+		if !deadlineReached(callCtx) || ctx.Err() != nil {
+			return result, err
+		}
+		if res, ok := result.(*mcp.CallToolResult); ok && err == nil && !res.IsError {
+			// Finished despite the deadline
+			return result, err
+		}
+
+		var sessionID string
+		var restarted bool
+		if sess != nil {
+			sessionID = sess.ID
+			// Only a call the worker is still running but cannot stop
+			// warrants a restart; if it found nothing the worker is idle.
+			switch outcome := s.cancelWorkerCall(sess.ID, worker.RequestID(ctx)); {
+			case outcome.stopped:
+				sess.MarkDirty()
+			case outcome.found:
+				restarted = s.restartStuckWorker(sess, tool, timeout)
+			}
+		}
+		s.logger.Printf("[Timeout] %s on session %s exceeded %s", tool, sessionID, timeout)
+		res, _, _ := s.handleToolError(toolTimedOut(tool, sessionID, timeout, restarted))
+		return res, nil
+	}
+}
+
+func deadlineReached(ctx context.Context) bool {
+	if err := ctx.Err(); err != nil {
+		return errors.Is(err, context.DeadlineExceeded)
+	}
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) < deadlineSlack
+}
+
+// restartStuckWorker kills a session's worker after a call timed out on it.
+// Crash recovery restarts it from the last saved database, so state that
+// described the killed worker is dropped.
+func (s *Server) restartStuckWorker(sess *session.Session, tool string, timeout time.Duration) bool {
+	if sess.Dormant() {
+		return false
+	}
+	if err := s.workers.Kill(sess.ID, fmt.Sprintf("%s exceeded its %s deadline", tool, timeout)); err != nil {
+		s.logger.Printf("[Timeout] Failed to kill worker for session %s: %v", sess.ID, err)
+		return false
+	}
+	s.deleteSessionCache(sess.ID)
+	s.clearProgress(sess.ID)
+	s.clearHistory(sess.ID)
+	return true
+}
@@ -13,6 +13,8 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -47,6 +49,7 @@ func TestOpenBinaryReusesActiveSession(t *testing.T) {
 	t.Parallel()
 	httpServer, workers := setupTestMCPServer(t)
 	defer httpServer.Close()
+	workers.noDecompiler = true
 
 	testBinary := filepath.Join(t.TempDir(), "reuse-test.bin")
 	sessionConn, sessionID1 := openTestSession(t, httpServer.URL, testBinary)
@@ -66,11 +69,1953 @@ func TestOpenBinaryReusesActiveSession(t *testing.T) {
 	if reused, _ := payload2["reused"].(bool); !reused {
 		t.Fatalf("expected reused flag to be true: %v", payload2)
 	}
+	if payload2["has_decompiler"] != false {
+		t.Fatalf("expected the reused session to report the worker's decompiler: %v", payload2)
+	}
 	if workers.StartCount(testBinary) != 1 {
 		t.Fatalf("expected worker to start once for binary, got %d", workers.StartCount(testBinary))
 	}
 }
 
+func TestAutoSaveSavesOnlyDirtySessions(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "autosave.bin"))
+	ctx := context.Background()
+
+	listSession := func() map[string]any {
+		resp, err := sessionConn.CallTool(ctx, &mcp.CallToolParams{Name: "list_sessions", Arguments: map[string]any{}})
+		if err != nil {
+			t.Fatalf("list_sessions: %v", err)
+		}
+		sessions, _ := decodeContent(t, resp)["sessions"].([]any)
+		if len(sessions) != 1 {
+			t.Fatalf("expected 1 session, got %v", sessions)
+		}
+		return sessions[0].(map[string]any)
+	}
+
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 0 {
+		t.Fatalf("clean session should not be saved, got %d saves", got)
+	}
+
+	if _, err := sessionConn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "set_name",
+		Arguments: map[string]any{"session_id": sessionID, "address": 0x1000, "name": "renamed"},
+	}); err != nil {
+		t.Fatalf("set_name: %v", err)
+	}
+	if dirty, _ := listSession()["dirty"].(bool); !dirty {
+		t.Fatal("expected session to be dirty after set_name")
+	}
+
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 1 {
+		t.Fatalf("expected 1 save, got %d", got)
+	}
+	entry := listSession()
+	if dirty, _ := entry["dirty"].(bool); dirty {
+		t.Fatalf("expected session to be clean after auto-save: %v", entry)
+	}
+	if saved, _ := entry["last_saved_at"].(float64); saved == 0 {
+		t.Fatalf("expected last_saved_at to be set: %v", entry)
+	}
+
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 1 {
+		t.Fatalf("expected no further saves for clean session, got %d", got)
+	}
+
+	// Changes only the worker knows about, such as auto-analysis, count too
+	workers.mu.Lock()
+	fake := workers.sessions[sessionID]
+	workers.mu.Unlock()
+	fake.mu.Lock()
+	fake.dirty = true
+	fake.mu.Unlock()
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 2 {
+		t.Fatalf("expected a save for the worker-reported changes, got %d saves", got)
+	}
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 2 {
+		t.Fatalf("expected no save once the worker is clean, got %d saves", got)
+	}
+
+	// A dirty status received before the last save is stale, even within
+	// the same second as the save
+	fake.mu.Lock()
+	fake.dirty = true
+	fake.statusAt = time.Now()
+	fake.mu.Unlock()
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 3 {
+		t.Fatalf("expected a save for the worker-reported changes, got %d saves", got)
+	}
+	fake.mu.Lock()
+	fake.dirty = true
+	fake.mu.Unlock()
+	srv.autoSaveDirtySessions(time.Millisecond)
+	if got := workers.SaveCount(sessionID); got != 3 {
+		t.Fatalf("expected no save for a status older than the save, got %d saves", got)
+	}
+}
+
+func TestGetWorkerStatusTool(t *testing.T) {
+	httpServer, _ := setupTestMCPServer(t)
+	defer httpServer.Close()
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "status.bin"))
+	ctx := context.Background()
+
+	resp, err := sessionConn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_worker_status",
+		Arguments: map[string]any{"session_id": sessionID},
+	})
+	if err != nil {
+		t.Fatalf("get_worker_status: %v", err)
+	}
+	payload := decodeContent(t, resp)
+	info, _ := payload["worker"].(map[string]any)
+	if running, _ := info["running"].(bool); !running {
+		t.Fatalf("expected running worker: %v", payload)
+	}
+	health, _ := info["health"].(map[string]any)
+	if mem, _ := health["memory_bytes"].(float64); mem != 64<<20 {
+		t.Fatalf("unexpected memory_bytes: %v", health)
+	}
+	if pending, _ := health["pending_requests"].(float64); pending != 1 {
+		t.Fatalf("unexpected pending_requests: %v", health)
+	}
+
+	resp, err = sessionConn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_worker_status",
+		Arguments: map[string]any{"session_id": "missing"},
+	})
+	if err != nil {
+		t.Fatalf("get_worker_status: %v", err)
+	}
+	if !resp.IsError {
+		t.Fatal("expected error for unknown session")
+	}
+}
+
+func TestWorkerKilledByLimitReportsResourceLimit(t *testing.T) {
+	httpServer, workers := setupTestMCPServer(t)
+	defer httpServer.Close()
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "limit.bin"))
+	workers.KillForLimit(sessionID, "memory 9000 MiB exceeded limit of 8192 MiB")
+
+	resp, err := sessionConn.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_entry_point",
+		Arguments: map[string]any{"session_id": sessionID},
+	})
+	if err != nil {
+		t.Fatalf("get_entry_point: %v", err)
+	}
+	if !resp.IsError {
+		t.Fatal("expected error result")
+	}
+	payload := decodeContent(t, resp)
+	if payload["kind"] != string(ErrResourceLimit) || payload["status"] != string(StatusPermanent) {
+		t.Fatalf("unexpected error payload: %v", payload)
+	}
+}
+
+func TestGetWorkerLogsAfterCrash(t *testing.T) {
+	httpServer, workers := setupTestMCPServer(t)
+	defer httpServer.Close()
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "logs.bin"))
+	workers.mu.Lock()
+	workers.logs[sessionID] = []worker.LogEntry{
+		{Time: time.Now(), Stream: "stderr", Level: "INFO", Line: "opening database"},
+		{Time: time.Now(), Stream: "stderr", Level: "ERROR", Line: "Traceback (most recent call last):"},
+	}
+	workers.mu.Unlock()
+	workers.KillForLimit(sessionID, "memory 9000 MiB exceeded limit of 8192 MiB")
+
+	resp, err := sessionConn.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_worker_logs",
+		Arguments: map[string]any{"session_id": sessionID, "tail": 1, "level": "error", "since": 1700000000},
+	})
+	if err != nil {
+		t.Fatalf("get_worker_logs: %v", err)
+	}
+	if resp.IsError {
+		t.Fatalf("unexpected error: %v", decodeContent(t, resp))
+	}
+	payload := decodeContent(t, resp)
+	lines, _ := payload["lines"].([]any)
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %v", payload)
+	}
+	if line := lines[0].(map[string]any); line["line"] != "Traceback (most recent call last):" {
+		t.Fatalf("unexpected line: %v", line)
+	}
+	workers.mu.Lock()
+	query := workers.logQuery
+	workers.mu.Unlock()
+	if query.MinLevel != "ERROR" || query.Since.Unix() != 1700000000 {
+		t.Fatalf("unexpected query: %+v", query)
+	}
+
+	resp, err = sessionConn.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_worker_logs",
+		Arguments: map[string]any{"session_id": sessionID, "level": "verbose"},
+	})
+	if err != nil {
+		t.Fatalf("get_worker_logs: %v", err)
+	}
+	if !resp.IsError {
+		t.Fatal("expected invalid level to fail")
+	}
+}
+
+func TestConcurrentOpenBinaryJoinsSingleSession(t *testing.T) {
+	httpServer, workers := setupTestMCPServer(t)
+	defer httpServer.Close()
+	workers.startDelay = 100 * time.Millisecond
+
+	binaryPath := filepath.Join(t.TempDir(), "shared.bin")
+	const callers = 4
+	results := concurrentOpenBinary(t, httpServer.URL, binaryPath, callers)
+
+	for _, payload := range results {
+		if payload["session_id"] != results[0]["session_id"] {
+			t.Fatalf("expected one session, got %v and %v", results[0], payload)
+		}
+	}
+	workers.mu.Lock()
+	starts := workers.starts[binaryPath]
+	workers.mu.Unlock()
+	if starts != 1 {
+		t.Fatalf("expected 1 worker start, got %d", starts)
+	}
+}
+
+func TestConcurrentOpenBinarySharesFailure(t *testing.T) {
+	httpServer, workers := setupTestMCPServer(t)
+	defer httpServer.Close()
+	workers.startDelay = 100 * time.Millisecond
+	workers.startErr = errors.New("spawn failed")
+
+	binaryPath := filepath.Join(t.TempDir(), "broken.bin")
+	const callers = 3
+	results := concurrentOpenBinary(t, httpServer.URL, binaryPath, callers)
+
+	for _, payload := range results {
+		if payload["kind"] != string(ErrWorkerUnavailable) {
+			t.Fatalf("expected worker_unavailable for every caller, got %v", payload)
+		}
+	}
+	workers.mu.Lock()
+	starts := workers.starts[binaryPath]
+	workers.mu.Unlock()
+	if starts != 1 {
+		t.Fatalf("expected 1 worker start, got %d", starts)
+	}
+
+	// The failed session is gone, so a later open starts afresh
+	workers.mu.Lock()
+	workers.startErr = nil
+	workers.mu.Unlock()
+	if payload := concurrentOpenBinary(t, httpServer.URL, binaryPath, 1)[0]; payload["session_id"] == nil {
+		t.Fatalf("expected a new session after the failure, got %v", payload)
+	}
+}
+
+// concurrentOpenBinary calls open_binary from n clients at once, each on its
+// own MCP connection, and returns the decoded results, errors or not.
+func concurrentOpenBinary(t *testing.T, endpoint, binaryPath string, n int) []map[string]any {
+	t.Helper()
+	ctx := context.Background()
+	responses := make([]*mcp.CallToolResult, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+			conn, err := client.Connect(ctx, &mcp.StreamableClientTransport{Endpoint: endpoint}, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			defer conn.Close()
+			responses[i], errs[i] = conn.CallTool(ctx, &mcp.CallToolParams{
+				Name:      "open_binary",
+				Arguments: map[string]any{"path": binaryPath},
+			})
+		}()
+	}
+	wg.Wait()
+
+	results := make([]map[string]any, n)
+	for i := range n {
+		if errs[i] != nil {
+			t.Fatalf("open_binary: %v", errs[i])
+		}
+		results[i] = decodeContent(t, responses[i])
+	}
+	return results
+}
+
+func TestBusySessionIsNotReaped(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	workers.analysisGate = make(chan struct{})
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "busy.bin"))
+	sess, _ := srv.registry.Get(sessionID)
+	sess.Timeout = time.Millisecond
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := sessionConn.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "run_auto_analysis",
+			Arguments: map[string]any{"session_id": sessionID},
+		})
+		done <- err
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for sess.InFlight() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("run_auto_analysis was not counted as in flight")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if expired := srv.registry.Expired(); len(expired) != 0 {
+		t.Fatalf("busy session reported expired")
+	}
+
+	close(workers.analysisGate)
+	if err := <-done; err != nil {
+		t.Fatalf("run_auto_analysis: %v", err)
+	}
+	if n := sess.InFlight(); n != 0 {
+		t.Fatalf("expected no calls in flight, got %d", n)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if expired := srv.registry.Expired(); len(expired) != 1 {
+		t.Fatalf("idle session should expire once the call finished")
+	}
+}
+
+func TestKeepalivePinsSession(t *testing.T) {
+	srv, httpServer, _ := setupTestServer(t)
+	defer httpServer.Close()
+
+	sessionConn, sessionID := openTestSession(t, httpServer.URL, filepath.Join(t.TempDir(), "pinned.bin"))
+	sess, _ := srv.registry.Get(sessionID)
+	sess.Timeout = time.Millisecond
+
+	pin := func(pinned bool) map[string]any {
+		resp, err := sessionConn.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "keepalive_session",
+			Arguments: map[string]any{"session_id": sessionID, "pin": pinned},
+		})
+		if err != nil {
+			t.Fatalf("keepalive_session: %v", err)
+		}
+		return decodeContent(t, resp)
+	}
+
+	if payload := pin(true); payload["pinned"] != true {
+		t.Fatalf("expected pinned session, got %v", payload)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if expired := srv.registry.Expired(); len(expired) != 0 {
+		t.Fatal("pinned session reported expired")
+	}
+
+	resp, err := sessionConn.CallTool(context.Background(), &mcp.CallToolParams{Name: "list_sessions"})
+	if err != nil {
+		t.Fatalf("list_sessions: %v", err)
+	}
+	entry := decodeContent(t, resp)["sessions"].([]any)[0].(map[string]any)
+	if entry["pinned"] != true || entry["in_flight"] != float64(0) {
+		t.Fatalf("unexpected list_sessions entry: %v", entry)
+	}
+
+	if payload := pin(false); payload["pinned"] != false || payload["expires_at"] == nil {
+		t.Fatalf("expected unpinned session with an expiry, got %v", payload)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if expired := srv.registry.Expired(); len(expired) != 1 {
+		t.Fatal("unpinned idle session should expire")
+	}
+}
+
+func TestDeleteDuringSuspendOrWake(t *testing.T) {
+	registry := session.NewRegistry(0)
+
+	// close_binary may delete a session while it is being suspended
+	sess, err := registry.Create("/tmp/suspend.bin", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Suspend(sess.ID, func(*session.Session, bool) error {
+		registry.Delete(sess.ID)
+		return nil
+	}); err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+	if _, ok := registry.Get(sess.ID); ok {
+		t.Fatal("expected the session to stay deleted")
+	}
+
+	// or while its worker is being started again
+	sess, err = registry.Create("/tmp/wake.bin", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Suspend(sess.ID, func(*session.Session, bool) error { return nil }); err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+	if err := registry.Wake(context.Background(), sess.ID, func(*session.Session) error {
+		registry.Delete(sess.ID)
+		return nil
+	}); err != nil {
+		t.Fatalf("wake: %v", err)
+	}
+	if _, ok := registry.Get(sess.ID); ok {
+		t.Fatal("expected the session to stay deleted")
+	}
+}
+
+func TestEvictLRUSessionAtLimit(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+
+	dir := t.TempDir()
+	paths := make([]string, 6)
+	for i := range paths {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("evict-%d.bin", i))
+	}
+	// The oldest session is pinned by config and must never be chosen
+	srv.SetEviction(EvictionReject, []string{paths[0]})
+
+	ids := make([]string, 0, len(paths))
+	var conn *mcp.ClientSession
+	for _, path := range paths[:4] {
+		var id string
+		conn, id = openTestSession(t, httpServer.URL, path)
+		ids = append(ids, id)
+	}
+	ctx := context.Background()
+	open := func(path string) *mcp.CallToolResult {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "open_binary",
+			Arguments: map[string]any{"path": path},
+		})
+		if err != nil {
+			t.Fatalf("open_binary: %v", err)
+		}
+		return resp
+	}
+
+	// The reject policy fails at max_concurrent_sessions (4)
+	resp := open(paths[4])
+	if payload := decodeContent(t, resp); !resp.IsError || payload["kind"] != string(ErrResourceLimit) {
+		t.Fatalf("expected resource_limit_exceeded, got %v", payload)
+	}
+
+	srv.SetEviction(EvictionLRU, []string{paths[0]})
+	workers.mu.Lock()
+	lru := workers.sessions[ids[1]]
+	workers.mu.Unlock()
+
+	resp = open(paths[4])
+	if resp.IsError {
+		t.Fatalf("open_binary with eviction failed: %v", decodeContent(t, resp))
+	}
+	evicted, _ := srv.registry.Get(ids[1])
+	if !evicted.Dormant() {
+		t.Fatal("least recently used session was not evicted")
+	}
+	lru.mu.Lock()
+	saves := lru.saves
+	lru.mu.Unlock()
+	if saves == 0 {
+		t.Fatal("evicted session was not saved")
+	}
+	if pinned, _ := srv.registry.Get(ids[0]); pinned.Dormant() || !pinned.Pinned() {
+		t.Fatal("pinned session was evicted")
+	}
+
+	// Using the evicted session wakes it and evicts the next idle one
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_functions",
+		Arguments: map[string]any{"session_id": ids[1]},
+	})
+	if err != nil || resp.IsError {
+		t.Fatalf("get_functions on evicted session: %v %v", err, resp)
+	}
+	if evicted.Dormant() {
+		t.Fatal("evicted session was not woken")
+	}
+	if next, _ := srv.registry.Get(ids[2]); !next.Dormant() {
+		t.Fatal("expected the next least recently used session to be evicted")
+	}
+
+	// With every other session pinned there is nothing to evict
+	for _, sess := range srv.registry.List() {
+		if !sess.Dormant() {
+			sess.SetPinned(true)
+		}
+	}
+	resp = open(paths[5])
+	if payload := decodeContent(t, resp); !resp.IsError || payload["status"] != string(StatusTemporary) {
+		t.Fatalf("expected a temporary resource limit error, got %v", payload)
+	}
+}
+
+func TestOpenBinaryMatchesContentHash(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+
+	dir := t.TempDir()
+	original := filepath.Join(dir, "original.so")
+	copied := filepath.Join(dir, "copy.so")
+	for _, path := range []string{original, copied} {
+		if err := os.WriteFile(path, []byte("\x7fELF v1"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	conn, sessionID := openTestSession(t, httpServer.URL, original)
+	ctx := context.Background()
+	open := func(path string) (*mcp.CallToolResult, map[string]any) {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "open_binary",
+			Arguments: map[string]any{"path": path},
+		})
+		if err != nil {
+			t.Fatalf("open_binary: %v", err)
+		}
+		return resp, decodeContent(t, resp)
+	}
+
+	// An identical copy resolves to the same session
+	_, payload := open(copied)
+	if payload["session_id"] != sessionID || payload["reused"] != true || payload["requested_path"] != copied {
+		t.Fatalf("expected copy to join session %s, got %v", sessionID, payload)
+	}
+	if sess, ok := srv.registry.FindBySHA256(payload["sha256"].(string)); !ok || sess.ID != sessionID {
+		t.Fatal("session not found by SHA-256")
+	}
+	workers.mu.Lock()
+	started := len(workers.starts)
+	workers.mu.Unlock()
+	if started != 1 {
+		t.Fatalf("expected one worker, got %d", started)
+	}
+
+	// Rebuilding the binary in place is reported
+	if err := os.WriteFile(original, []byte("\x7fELF v2 rebuilt"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, payload = open(original)
+	if payload["session_id"] != sessionID || payload["warning"] == nil {
+		t.Fatalf("expected a stale binary warning, got %v", payload)
+	}
+
+	srv.SetStaleBinaryPolicy(StaleBinaryRefuse)
+	resp, payload := open(original)
+	if !resp.IsError || payload["kind"] != string(ErrInvalidInput) {
+		t.Fatalf("expected stale binary to be refused, got %v", payload)
+	}
+}
+
+func TestManagedDatabaseStorageAndGC(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	dataDir := t.TempDir()
+	srv.SetDatabaseDirectory(dataDir)
+
+	// Evidence mounts are read-only; nothing may be written next to the binary
+	evidence := t.TempDir()
+	binaryPath := filepath.Join(evidence, "sample.exe")
+	if err := os.WriteFile(binaryPath, []byte("MZ sample"), 0o444); err != nil {
+		t.Fatal(err)
+	}
+	identity, err := session.IdentifyFile(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, sessionID := openTestSession(t, httpServer.URL, binaryPath)
+	want := filepath.Join(dataDir, "databases", identity.SHA256, "sample.exe.i64")
+	workers.mu.Lock()
+	placed := workers.sessions[sessionID].databasePath
+	workers.mu.Unlock()
+	if placed != want {
+		t.Fatalf("worker told to keep the database at %q, want %q", placed, want)
+	}
+	// The worker writes it on first save
+	if err := os.MkdirAll(filepath.Dir(want), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(want, []byte("live database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	orphan := func(kind, name string, age time.Duration) string {
+		dir := filepath.Join(dataDir, kind, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "old.i64"), make([]byte, 1024), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		old := time.Now().Add(-age)
+		if err := os.Chtimes(dir, old, old); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+	stale := orphan("databases", strings.Repeat("ab", 32), time.Hour)
+	staleSnapshots := orphan("snapshots", "closed-session", time.Hour)
+	filling := orphan("forks", "in-progress", 0)
+
+	ctx := context.Background()
+	call := func(name string, args map[string]any) (map[string]any, bool) {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		return decodeContent(t, resp), resp.IsError
+	}
+	exists := func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	// Automatic pruning keeps a closed binary's database for its next open
+	staleFork := orphan("forks", "closed-fork", time.Hour)
+	srv.PruneDatabases()
+	if !exists(stale) || exists(staleFork) {
+		t.Fatal("automatic pruning must remove only forks, imports and snapshots")
+	}
+	staleSnapshots = orphan("snapshots", "closed-session", time.Hour)
+
+	report, isErr := call("gc_databases", map[string]any{"dry_run": true})
+	if isErr || report["freed_bytes"] != float64(2048) || len(report["removed"].([]any)) != 2 {
+		t.Fatalf("unexpected dry run report: %v", report)
+	}
+	if !exists(stale) {
+		t.Fatal("dry run deleted files")
+	}
+	report, isErr = call("gc_databases", map[string]any{})
+	if isErr || report["freed_bytes"] != float64(2048) {
+		t.Fatalf("unexpected gc report: %v", report)
+	}
+	if exists(stale) || exists(staleSnapshots) {
+		t.Fatal("orphaned directories survived gc")
+	}
+	if !exists(filling) || !exists(want) {
+		t.Fatal("gc removed a live or recent directory")
+	}
+
+	// The live database alone fills a session quota of its size, and the
+	// fork still being filled fills a total quota of 1 KiB
+	srv.sessionQuota = int64(len("live database"))
+	payload, isErr := call("snapshot_database", map[string]any{"session_id": sessionID})
+	if !isErr || payload["kind"] != "resource_limit_exceeded" {
+		t.Fatalf("expected session quota refusal, got %v", payload)
+	}
+	srv.sessionQuota = 0
+	srv.databaseQuota = 1024
+	other := filepath.Join(evidence, "other.exe")
+	if err := os.WriteFile(other, []byte("MZ other"), 0o444); err != nil {
+		t.Fatal(err)
+	}
+	payload, isErr = call("open_binary", map[string]any{"path": other})
+	if !isErr || payload["kind"] != "resource_limit_exceeded" {
+		t.Fatalf("expected storage quota refusal, got %v", payload)
+	}
+	// Joining the existing session places nothing new
+	if payload, isErr = call("open_binary", map[string]any{"path": binaryPath}); isErr || payload["reused"] != true {
+		t.Fatalf("reopen refused: %v", payload)
+	}
+}
+
+func TestRestoreSessionsSkipsUnreadableRecords(t *testing.T) {
+	srv, httpServer, _ := setupTestServer(t)
+	defer httpServer.Close()
+
+	// open_binary persists what the worker reported about the database
+	_, sessionID := openTestSession(t, httpServer.URL, "/tmp/facts.bin")
+	metas, _, err := srv.store.Load()
+	if err != nil || len(metas) != 1 || metas[0].ID != sessionID {
+		t.Fatalf("unexpected stored sessions: %+v %v", metas, err)
+	}
+	if m := metas[0]; !m.HasDecompiler || m.Processor != "metapc" || m.Bitness != 64 {
+		t.Fatalf("database facts not persisted: %+v", m)
+	}
+
+	dir := t.TempDir()
+	write := func(name, data string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("legacy.json", `{"id":"legacy","binary_path":"/tmp/legacy.bin","created_at":"2025-01-02T03:04:05Z","timeout":3600000000000,"has_decompiler":false}`)
+	write("corrupt.json", `{"id":"corrupt",`)
+	write("future.json", `{"version":99,"session":{"id":"future"}}`)
+
+	store, err := session.NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.store = store
+	srv.RestoreSessions()
+	if _, ok := srv.registry.Get("legacy"); !ok {
+		t.Fatal("legacy session not restored next to unreadable records")
+	}
+	for _, id := range []string{"corrupt", "future"} {
+		if _, ok := srv.registry.Get(id); ok {
+			t.Fatalf("unreadable record %s restored", id)
+		}
+	}
+}
+
+func TestChangeJournal(t *testing.T) {
+	srv, httpServer, _ := setupTestServer(t)
+	defer httpServer.Close()
+	srv.SetDatabaseDirectory(t.TempDir())
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/journal.bin")
+	ctx := context.Background()
+
+	call := func(name string, args map[string]any) map[string]any {
+		t.Helper()
+		args["session_id"] = sessionID
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil || resp.IsError {
+			t.Fatalf("%s failed: %v %v", name, resp, err)
+		}
+		return decodeContent(t, resp)
+	}
+	call("set_name", map[string]any{"address": 0x1000, "name": "first"})
+	call("set_name", map[string]any{"address": 0x1000, "name": "second"})
+	call("set_comment", map[string]any{"address": 0x2000, "comment": "checks the key"})
+	call("rename_lvar", map[string]any{"function_address": 0x1000, "lvar_name": "v1", "new_name": "key"})
+	call("run_auto_analysis", map[string]any{})
+
+	all := call("get_change_log", map[string]any{})
+	changes, _ := all["changes"].([]any)
+	if len(changes) != 5 || all["total"] != float64(5) {
+		t.Fatalf("expected 5 journaled changes, got %v", all)
+	}
+	if last := changes[4].(map[string]any); last["tool"] != "run_auto_analysis" {
+		t.Fatalf("auto-analysis not journaled: %v", last)
+	}
+	first := changes[0].(map[string]any)
+	client, _ := first["client"].(map[string]any)
+	if first["seq"] != float64(1) || first["tool"] != "set_name" || client["user_agent"] == nil || first["time"] == nil {
+		t.Fatalf("unexpected first change: %v", first)
+	}
+	if args, _ := first["args"].(map[string]any); args["name"] != "first" {
+		t.Fatalf("call arguments not journaled: %v", first)
+	}
+
+	byAddress := call("get_change_log", map[string]any{"address": 0x1000, "tool": "set_name"})
+	changes, _ = byAddress["changes"].([]any)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 set_name changes at 0x1000, got %v", byAddress)
+	}
+	second := changes[1].(map[string]any)
+	if second["prior"] != "first" || second["value"] != "second" {
+		t.Fatalf("prior value not journaled: %v", second)
+	}
+	lvar := call("get_change_log", map[string]any{"tool": "rename_lvar"})["changes"].([]any)[0].(map[string]any)
+	if lvar["prior"] != "v1" || lvar["value"] != "key" {
+		t.Fatalf("unexpected rename_lvar change: %v", lvar)
+	}
+	future := call("get_change_log", map[string]any{"since": time.Now().Add(time.Hour).Unix()})
+	if future["total"] != float64(0) {
+		t.Fatalf("expected no changes after since, got %v", future)
+	}
+
+	// The journal survives closing the session
+	call("close_binary", map[string]any{})
+	if got := call("get_change_log", map[string]any{"tool": "set_comment"}); got["total"] != float64(1) {
+		t.Fatalf("journal not kept after close: %v", got)
+	}
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_change_log",
+		Arguments: map[string]any{"session_id": "../sessions/x"},
+	})
+	if err != nil || !resp.IsError {
+		t.Fatalf("expected a malformed session ID to fail, got %v %v", resp, err)
+	}
+}
+
+func TestReplayAnnotations(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	srv.SetDatabaseDirectory(t.TempDir())
+	ctx := context.Background()
+	conn, sourceID := openTestSession(t, httpServer.URL, "/tmp/vendor-v1.bin")
+	_, targetID := openTestSession(t, httpServer.URL, "/tmp/vendor-v2.bin")
+
+	call := func(name string, args map[string]any) map[string]any {
+		t.Helper()
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil || resp.IsError {
+			t.Fatalf("%s failed: %v %v", name, resp, err)
+		}
+		return decodeContent(t, resp)
+	}
+	call("set_name", map[string]any{"session_id": sourceID, "address": 0x1000, "name": "decrypt_config"})
+	call("set_comment", map[string]any{"session_id": sourceID, "address": 0x2000, "comment": "xor key"})
+	call("rename_lvar", map[string]any{"session_id": sourceID, "function_address": 0x1000, "lvar_name": "v1", "new_name": "key"})
+	// The new build was already annotated at 0x2000 by someone else
+	call("set_comment", map[string]any{"session_id": targetID, "address": 0x2000, "comment": "their note"})
+
+	dry := call("replay_annotations", map[string]any{"session_id": targetID, "source_session_id": sourceID, "dry_run": true})
+	if dry["would_apply"] != float64(2) || dry["conflicts"] != float64(1) || dry["applied"] != float64(0) {
+		t.Fatalf("unexpected dry run: %v", dry)
+	}
+	workers.mu.Lock()
+	target := workers.sessions[targetID]
+	workers.mu.Unlock()
+	if name := target.annotation(&target.names, 0x1000); name != "" {
+		t.Fatalf("dry run changed the target: %q", name)
+	}
+
+	replay := call("replay_annotations", map[string]any{"session_id": targetID, "source_session_id": sourceID})
+	if replay["applied"] != float64(2) || replay["conflicts"] != float64(1) {
+		t.Fatalf("unexpected replay: %v", replay)
+	}
+	results, _ := replay["results"].([]any)
+	conflict := results[1].(map[string]any)
+	if conflict["status"] != "conflict" || conflict["current"] != "their note" || conflict["seq"] != float64(2) {
+		t.Fatalf("unexpected conflict report: %v", conflict)
+	}
+	if name := target.annotation(&target.names, 0x1000); name != "decrypt_config" {
+		t.Fatalf("name not replayed, got %q", name)
+	}
+	if comment := target.annotation(&target.comments, 0x2000); comment != "their note" {
+		t.Fatalf("conflicting comment overwritten: %q", comment)
+	}
+	// Replayed changes are journaled on the target like any other
+	if log := call("get_change_log", map[string]any{"session_id": targetID, "tool": "set_name"}); log["total"] != float64(1) {
+		t.Fatalf("replayed change not journaled: %v", log)
+	}
+	again := call("replay_annotations", map[string]any{"session_id": targetID, "source_session_id": sourceID, "overwrite": true})
+	if again["unchanged"] != float64(1) || again["applied"] != float64(2) {
+		t.Fatalf("unexpected second replay: %v", again)
+	}
+
+	steps := call("replay_annotations", map[string]any{
+		"session_id": targetID,
+		"steps": []map[string]any{
+			{"tool": "set_name", "anchor": "name", "name": targetID + "_start", "offset": 0x10, "value": "inner"},
+			{"tool": "set_name", "anchor": "name", "name": "missing", "value": "lost"},
+			{"tool": "set_function_type", "anchor": "pattern", "pattern": "55 48 89 e5", "value": "int f(void)"},
+		},
+	})
+	results, _ = steps["results"].([]any)
+	if steps["applied"] != float64(1) || steps["unanchored"] != float64(2) || results[0].(map[string]any)["target_address"] != float64(0x1010) {
+		t.Fatalf("unexpected anchored replay: %v", steps)
+	}
+	if reason, _ := results[2].(map[string]any)["reason"].(string); !strings.Contains(reason, "matches 2 places") {
+		t.Fatalf("ambiguous pattern not reported: %v", results[2])
+	}
+
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name: "replay_annotations",
+		Arguments: map[string]any{
+			"session_id": targetID,
+			"steps":      []map[string]any{{"tool": "import_il2cpp"}},
+		},
+	})
+	if err != nil || !resp.IsError {
+		t.Fatalf("expected a step for a tool that cannot be replayed to fail, got %v %v", resp, err)
+	}
+}
+
+func TestUndoRedo(t *testing.T) {
+	_, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	ctx := context.Background()
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/undo.bin")
+	workers.mu.Lock()
+	fake := workers.sessions[sessionID]
+	workers.mu.Unlock()
+
+	call := func(name string, args map[string]any) map[string]any {
+		t.Helper()
+		args["session_id"] = sessionID
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil || resp.IsError {
+			t.Fatalf("%s failed: %v %v", name, resp, err)
+		}
+		return decodeContent(t, resp)
+	}
+	call("set_name", map[string]any{"address": 0x1000, "name": "first"})
+	call("set_name", map[string]any{"address": 0x1000, "name": "second"})
+	call("set_comment", map[string]any{"address": 0x2000, "comment": "checks the licence"})
+	call("set_function_type", map[string]any{"address": 0x1000, "prototype": "int second(void)"})
+	call("set_lvar_type", map[string]any{"function_address": 0x1000, "lvar_name": "v1", "lvar_type": "int"})
+
+	stack := call("list_undo_stack", map[string]any{})
+	undoStack, _ := stack["undo"].([]any)
+	if len(undoStack) != 5 {
+		t.Fatalf("expected 5 changes on the undo stack, got %v", stack)
+	}
+	top := undoStack[0].(map[string]any)
+	if top["tool"] != "set_lvar_type" || top["undoable"] != false || top["reason"] == nil {
+		t.Fatalf("expected an irreversible set_lvar_type on top, got %v", top)
+	}
+	if second := undoStack[3].(map[string]any); second["prior"] != "first" || second["value"] != "second" || second["undoable"] != true {
+		t.Fatalf("unexpected rename entry: %v", second)
+	}
+
+	blocked := call("undo", map[string]any{"count": 2})
+	if blocked["undone"] != float64(0) || blocked["stopped"] == nil {
+		t.Fatalf("expected undo to stop at the irreversible change, got %v", blocked)
+	}
+
+	undone := call("undo", map[string]any{"count": 2, "skip_irreversible": true})
+	changes, _ := undone["changes"].([]any)
+	if undone["undone"] != float64(2) || len(changes) != 4 || undone["undo_depth"] != float64(1) || undone["redo_depth"] != float64(2) {
+		t.Fatalf("unexpected undo: %v", undone)
+	}
+	// The function type had none before, which IDA cannot restore
+	if dropped := changes[1].(map[string]any); dropped["tool"] != "set_function_type" || dropped["status"] != "dropped" {
+		t.Fatalf("expected the function type to be dropped, got %v", dropped)
+	}
+	if name := fake.annotation(&fake.names, 0x1000); name != "first" {
+		t.Fatalf("expected the rename to be reverted, got %q", name)
+	}
+	if comment := fake.annotation(&fake.comments, 0x2000); comment != "" {
+		t.Fatalf("expected the comment to be reverted, got %q", comment)
+	}
+
+	redone := call("redo", map[string]any{})
+	if redone["redone"] != float64(1) || fake.annotation(&fake.names, 0x1000) != "second" {
+		t.Fatalf("unexpected redo: %v", redone)
+	}
+	// A new change discards what is left to redo
+	call("rename_global", map[string]any{"address": 0x6000, "new_name": "g_config"})
+	stack = call("list_undo_stack", map[string]any{})
+	if redoStack, _ := stack["redo"].([]any); len(redoStack) != 0 {
+		t.Fatalf("expected a new change to clear the redo stack, got %v", stack)
+	}
+
+	undone = call("undo", map[string]any{"count": 5})
+	if undone["undone"] != float64(3) || undone["undo_depth"] != float64(0) {
+		t.Fatalf("unexpected undo: %v", undone)
+	}
+	if name := fake.annotation(&fake.names, 0x1000); name != "" {
+		t.Fatalf("expected the first name to be deleted, got %q", name)
+	}
+	if name := fake.annotation(&fake.names, 0x6000); name != "" {
+		t.Fatalf("expected the global rename to be reverted, got %q", name)
+	}
+	if empty := call("undo", map[string]any{}); empty["undone"] != float64(0) {
+		t.Fatalf("expected nothing left to undo, got %v", empty)
+	}
+
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "undo",
+		Arguments: map[string]any{"session_id": sessionID, "count": -1},
+	})
+	if err != nil || !resp.IsError {
+		t.Fatalf("expected a negative count to be rejected, got %v %v", resp, err)
+	}
+}
+
+func TestToolTimeoutRestartsStuckWorker(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	srv.SetToolTimeouts(30, nil)
+	for _, tool := range []string{"snapshot_database", "restore_snapshot", "fork_session", "export_session"} {
+		if srv.timeoutFor(tool) != 0 {
+			t.Fatalf("expected %s to copy files without a deadline, got %s", tool, srv.timeoutFor(tool))
+		}
+	}
+	if srv.timeoutFor("get_functions") != 30*time.Second {
+		t.Fatalf("expected the default deadline for get_functions, got %s", srv.timeoutFor("get_functions"))
+	}
+	srv.SetToolTimeouts(0, map[string]int{"find_binary": 0})
+	if srv.timeoutFor("get_decompiled_func") != time.Minute || srv.timeoutFor("find_binary") != 0 || srv.timeoutFor("get_functions") != 0 {
+		t.Fatalf("unexpected deadlines: %v (default %s)", srv.toolTimeouts, srv.toolTimeout)
+	}
+	// Seconds are too coarse for a test
+	srv.toolTimeouts["get_decompiled_func"] = 200 * time.Millisecond
+
+	ctx := context.Background()
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/stuck.bin")
+	workers.mu.Lock()
+	stuck := workers.sessions[sessionID]
+	workers.mu.Unlock()
+	stuck.mu.Lock()
+	stuck.stuck = true
+	stuck.mu.Unlock()
+
+	start := time.Now()
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_decompiled_func",
+		Arguments: map[string]any{"session_id": sessionID, "address": 0x1000},
+	})
+	if err != nil || !resp.IsError {
+		t.Fatalf("expected the stuck call to time out, got %v %v", resp, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("deadline not enforced, call took %s", elapsed)
+	}
+	toolErr := decodeContent(t, resp)
+	toolCtx, _ := toolErr["context"].(map[string]any)
+	if toolErr["kind"] != "timeout" || toolErr["status"] != "temporary" || toolCtx["worker_restarted"] != true || toolCtx["retry_after_seconds"] == nil {
+		t.Fatalf("unexpected timeout error: %v", toolErr)
+	}
+	if reason := workers.KillReason(sessionID); !strings.Contains(reason, "get_decompiled_func exceeded") {
+		t.Fatalf("expected the stuck worker to be killed, got %q", reason)
+	}
+	stuck.mu.Lock()
+	cancels, stuckID := stuck.cancels, stuck.stuckID
+	stuck.mu.Unlock()
+	if len(cancels) != 1 || cancels[0] != stuckID {
+		t.Fatalf("expected the call to be cancelled before the kill, got %v for %q", cancels, stuckID)
+	}
+
+	// The replacement worker serves the session again
+	resp, err = conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_decompiled_func",
+		Arguments: map[string]any{"session_id": sessionID, "address": 0x1000},
+	})
+	if err != nil || resp.IsError {
+		t.Fatalf("expected the restarted worker to answer, got %v %v", resp, err)
+	}
+}
+
+func TestToolTimeoutKeepsWorkerWhenCallNotFound(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	srv.SetToolTimeouts(0, nil)
+	srv.toolTimeouts["get_decompiled_func"] = 200 * time.Millisecond
+
+	ctx := context.Background()
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/idle.bin")
+	workers.mu.Lock()
+	fake := workers.sessions[sessionID]
+	workers.mu.Unlock()
+	// The worker answers Cancel as if the call had already finished
+	fake.mu.Lock()
+	fake.stuck = true
+	fake.cancelMisses = true
+	fake.mu.Unlock()
+
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_decompiled_func",
+		Arguments: map[string]any{"session_id": sessionID, "address": 0x1000},
+	})
+	if err != nil || !resp.IsError {
+		t.Fatalf("expected the call to time out, got %v %v", resp, err)
+	}
+	toolErr := decodeContent(t, resp)
+	toolCtx, _ := toolErr["context"].(map[string]any)
+	if toolErr["kind"] != "timeout" || toolCtx["worker_restarted"] == true {
+		t.Fatalf("unexpected timeout error: %v", toolErr)
+	}
+	if reason := workers.KillReason(sessionID); reason != "" {
+		t.Fatalf("expected the idle worker to be kept, got kill %q", reason)
+	}
+	fake.mu.Lock()
+	cancels := fake.cancels
+	fake.mu.Unlock()
+	if len(cancels) != 1 {
+		t.Fatalf("expected one Cancel before giving up on the call, got %v", cancels)
+	}
+}
+
+func TestCancelledCallCancelsWorkerCall(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+
+	// The stateless streamable transport drops notifications/cancelled;
+	// SSE keeps a session that delivers it
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+	conn, err := client.Connect(context.Background(), &mcp.SSEClientTransport{Endpoint: httpServer.URL + "/sse"}, nil)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer conn.Close()
+	openResp, err := conn.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "open_binary",
+		Arguments: map[string]any{"path": "/tmp/cancel.bin"},
+	})
+	if err != nil {
+		t.Fatalf("open_binary: %v", err)
+	}
+	sessionID, _ := decodeContent(t, openResp)["session_id"].(string)
+	workers.mu.Lock()
+	fake := workers.sessions[sessionID]
+	workers.mu.Unlock()
+	fake.mu.Lock()
+	fake.stuck = true
+	fake.mu.Unlock()
+	sess, _ := srv.registry.Get(sessionID)
+	if sess.IsDirty() {
+		t.Fatal("expected a freshly opened session to be clean")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "get_decompiled_func",
+			Arguments: map[string]any{"session_id": sessionID, "address": 0x1000},
+		})
+		done <- err
+	}()
+
+	var stuckID string
+	deadline := time.Now().Add(5 * time.Second)
+	for stuckID == "" && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		fake.mu.Lock()
+		stuckID = fake.stuckID
+		fake.mu.Unlock()
+	}
+	if stuckID == "" {
+		t.Fatal("expected the worker call to carry a request ID")
+	}
+	cancel()
+	if err := <-done; err == nil {
+		t.Fatal("expected the cancelled call to return an error")
+	}
+
+	var cancels []string
+	for time.Now().Before(deadline) {
+		fake.mu.Lock()
+		cancels = append([]string(nil), fake.cancels...)
+		fake.mu.Unlock()
+		if len(cancels) > 0 && sess.IsDirty() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(cancels) != 1 || cancels[0] != stuckID {
+		t.Fatalf("expected Cancel for %q, got %v", stuckID, cancels)
+	}
+	if !sess.IsDirty() {
+		t.Fatal("expected a cancelled call to leave the session dirty")
+	}
+}
+
+func TestSessionQueueOrdersAndCoalescesCalls(t *testing.T) {
+	_, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	workers.analysisGate = make(chan struct{})
+
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/queue.bin")
+	ctx := context.Background()
+	queue := func() map[string]any {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "get_session_queue",
+			Arguments: map[string]any{"session_id": sessionID},
+		})
+		if err != nil || resp.IsError {
+			t.Fatalf("get_session_queue: %v %v", resp, err)
+		}
+		return decodeContent(t, resp)
+	}
+	waitDepth := func(depth float64) map[string]any {
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			q := queue()
+			if q["depth"] == depth && q["running"] != nil {
+				return q
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %v queued calls, got %v", depth, q)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	var wg sync.WaitGroup
+	call := func(name string, args map[string]any) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			args["session_id"] = sessionID
+			if _, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args}); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}()
+	}
+	call("run_auto_analysis", map[string]any{})
+	waitDepth(0)
+	call("get_functions", map[string]any{})
+	waitDepth(1)
+	call("get_name", map[string]any{"address": 0x1000})
+	call("get_name", map[string]any{"address": 0x1000})
+	call("set_name", map[string]any{"address": 0x2000, "name": "queued"})
+
+	var q map[string]any
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		q = waitDepth(3)
+		stats, _ := q["stats"].(map[string]any)
+		interactive, _ := stats["interactive"].(map[string]any)
+		if interactive["coalesced"] == 1.0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the identical get_name calls to coalesce, got %v", q)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	running, _ := q["running"].(map[string]any)
+	if running["tool"] != "run_auto_analysis" || running["priority"] != "bulk" {
+		t.Fatalf("unexpected running call: %v", running)
+	}
+	queued, _ := q["queued"].([]any)
+	var order []string
+	for _, entry := range queued {
+		c, _ := entry.(map[string]any)
+		order = append(order, c["tool"].(string))
+	}
+	if strings.Join(order, ",") != "get_name,set_name,get_functions" {
+		t.Fatalf("expected reads before writes before enumerations, got %v", order)
+	}
+	if first, _ := queued[0].(map[string]any); first["coalesced"] != 1.0 {
+		t.Fatalf("expected one call to share the queued get_name, got %v", first)
+	}
+
+	close(workers.analysisGate)
+	wg.Wait()
+	q = queue()
+	stats, _ := q["stats"].(map[string]any)
+	interactive, _ := stats["interactive"].(map[string]any)
+	bulk, _ := stats["bulk"].(map[string]any)
+	if q["depth"] != 0.0 || q["running"] != nil || interactive["completed"] != 1.0 || bulk["completed"] != 2.0 {
+		t.Fatalf("unexpected queue after the calls finished: %v", q)
+	}
+}
+
+func TestAutoSaveWaitsInSessionQueue(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	workers.analysisGate = make(chan struct{})
+	release := sync.OnceFunc(func() { close(workers.analysisGate) })
+	defer release()
+
+	conn, sessionID := openTestSession(t, httpServer.URL, "/tmp/queued-save.bin")
+	ctx := context.Background()
+	analysis := make(chan error, 1)
+	go func() {
+		_, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "run_auto_analysis",
+			Arguments: map[string]any{"session_id": sessionID},
+		})
+		analysis <- err
+	}()
+
+	sess, _ := srv.registry.Get(sessionID)
+	sess.MarkDirty()
+	q := srv.sessionQueue(sessionID)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		q.mu.Lock()
+		running := q.running
+		q.mu.Unlock()
+		if running != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("run_auto_analysis did not take the worker")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	saved := make(chan struct{})
+	go func() {
+		srv.autoSaveDirtySessions(time.Millisecond)
+		close(saved)
+	}()
+	for {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "get_session_queue",
+			Arguments: map[string]any{"session_id": sessionID},
+		})
+		if err != nil || resp.IsError {
+			t.Fatalf("get_session_queue: %v %v", resp, err)
+		}
+		queued, _ := decodeContent(t, resp)["queued"].([]any)
+		if len(queued) == 1 && queued[0].(map[string]any)["tool"] == "auto_save" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected auto-save to wait in the session queue, got %v", queued)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := workers.SaveCount(sessionID); got != 0 {
+		t.Fatalf("auto-save ran during run_auto_analysis: %d saves", got)
+	}
+
+	release()
+	if err := <-analysis; err != nil {
+		t.Fatalf("run_auto_analysis: %v", err)
+	}
+	<-saved
+	if got := workers.SaveCount(sessionID); got != 1 {
+		t.Fatalf("expected the queued auto-save to run, got %d saves", got)
+	}
+}
+
+func TestHeavyOperationsWaitForServerWideSlot(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	srv.heavy = newHeavyLimiter(1)
+	workers.analysisGate = make(chan struct{})
+
+	first, firstID := openTestSession(t, httpServer.URL, "/tmp/heavy-a.bin")
+	_, secondID := openTestSession(t, httpServer.URL, "/tmp/heavy-b.bin")
+	ctx := context.Background()
+
+	progress := make(chan string, 16)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+			select {
+			case progress <- req.Params.Message:
+			default:
+			}
+		},
+	})
+	// Progress notifications need a session, which SSE keeps
+	second, err := client.Connect(ctx, &mcp.SSEClientTransport{Endpoint: httpServer.URL + "/sse"}, nil)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer second.Close()
+	// Let held calls finish if the test fails before releasing them
+	release := sync.OnceFunc(func() { close(workers.analysisGate) })
+	defer release()
+
+	done := make(chan error, 3)
+	go func() {
+		_, err := first.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "run_auto_analysis",
+			Arguments: map[string]any{"session_id": firstID},
+		})
+		done <- err
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		srv.heavy.mu.Lock()
+		running := srv.heavy.running
+		srv.heavy.mu.Unlock()
+		if running == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("first run_auto_analysis did not take the slot")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	go func() {
+		params := &mcp.CallToolParams{
+			Name:      "run_auto_analysis",
+			Arguments: map[string]any{"session_id": secondID},
+		}
+		params.SetProgressToken("heavy")
+		_, err := second.CallTool(ctx, params)
+		done <- err
+	}()
+	select {
+	case msg := <-progress:
+		if !strings.Contains(msg, "position 1 of 1") {
+			t.Fatalf("unexpected queue progress: %q", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a queue position progress notification")
+	}
+	workers.mu.Lock()
+	waiting := workers.sessions[secondID]
+	workers.mu.Unlock()
+	waiting.mu.Lock()
+	analyzed := waiting.analyzed
+	waiting.mu.Unlock()
+	if analyzed {
+		t.Fatal("the waiting call reached its worker")
+	}
+
+	// A heavy call still queued behind its own session's analysis does not
+	// take a place in line for a slot
+	go func() {
+		_, err := first.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "get_strings",
+			Arguments: map[string]any{"session_id": firstID},
+		})
+		done <- err
+	}()
+	queue := srv.sessionQueue(firstID)
+	for {
+		queue.mu.Lock()
+		queued := len(queue.queued)
+		queue.mu.Unlock()
+		if queued == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("get_strings was not queued on its session")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	srv.heavy.mu.Lock()
+	heavyWaiting := len(srv.heavy.waiting)
+	srv.heavy.mu.Unlock()
+	if heavyWaiting != 1 {
+		t.Fatalf("expected only the other session's call to wait for a slot, got %d", heavyWaiting)
+	}
+
+	release()
+	for range 3 {
+		if err := <-done; err != nil {
+			t.Fatalf("run_auto_analysis: %v", err)
+		}
+	}
+	waiting.mu.Lock()
+	analyzed = waiting.analyzed
+	waiting.mu.Unlock()
+	if !analyzed {
+		t.Fatal("the waiting call never ran")
+	}
+	srv.heavy.mu.Lock()
+	defer srv.heavy.mu.Unlock()
+	if srv.heavy.running != 0 || len(srv.heavy.waiting) != 0 {
+		t.Fatalf("slots not released: %d running, %d waiting", srv.heavy.running, len(srv.heavy.waiting))
+	}
+}
+
+func TestSnapshotAndRestoreDatabase(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	dataDir := t.TempDir()
+	srv.SetDatabaseDirectory(dataDir)
+
+	dir := t.TempDir()
+	binaryPath := filepath.Join(dir, "target.so")
+	if err := os.WriteFile(binaryPath, []byte("\x7fELF"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(binaryPath+".i64", []byte("database v1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, sessionID := openTestSession(t, httpServer.URL, binaryPath)
+	// The existing database was carried over into managed storage
+	sess, _ := srv.registry.Get(sessionID)
+	dbPath := sess.DatabasePath
+	if !strings.HasPrefix(dbPath, filepath.Join(dataDir, "databases")) {
+		t.Fatalf("database placed at %q", dbPath)
+	}
+	if data, err := os.ReadFile(dbPath); err != nil || string(data) != "database v1" {
+		t.Fatalf("existing database not carried over: %q %v", data, err)
+	}
+	ctx := context.Background()
+	call := func(name string, args map[string]any) map[string]any {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		payload := decodeContent(t, resp)
+		if resp.IsError {
+			t.Fatalf("%s failed: %v", name, payload)
+		}
+		return payload
+	}
+
+	snap := call("snapshot_database", map[string]any{"session_id": sessionID, "label": "before renames"})
+	if snap["snapshot_id"] != float64(1) || snap["size"] != float64(len("database v1")) {
+		t.Fatalf("unexpected snapshot: %v", snap)
+	}
+
+	// Bulk changes go wrong, leaving a newer database and unpacked files
+	if err := os.WriteFile(dbPath, []byte("database v2"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	unpacked := strings.TrimSuffix(dbPath, ".i64") + ".id0"
+	if err := os.WriteFile(unpacked, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	listed := call("list_snapshots", map[string]any{"session_id": sessionID})
+	if listed["count"] != float64(1) {
+		t.Fatalf("expected one snapshot, got %v", listed)
+	}
+
+	restored := call("restore_snapshot", map[string]any{"session_id": sessionID, "snapshot_id": 1})
+	if restored["restored"] != true || restored["label"] != "before renames" {
+		t.Fatalf("unexpected restore result: %v", restored)
+	}
+	data, err := os.ReadFile(dbPath)
+	if err != nil || string(data) != "database v1" {
+		t.Fatalf("database not restored: %q %v", data, err)
+	}
+	if _, err := os.Stat(unpacked); !os.IsNotExist(err) {
+		t.Fatal("unpacked database files were not removed")
+	}
+	workers.mu.Lock()
+	starts := workers.starts[binaryPath]
+	workers.mu.Unlock()
+	if starts != 2 {
+		t.Fatalf("expected the worker to restart, got %d starts", starts)
+	}
+	// The restarted worker serves calls under the same session ID
+	call("get_functions", map[string]any{"session_id": sessionID})
+
+	// Snapshot metadata survives a restart
+	metas, _, err := srv.store.Load()
+	if err != nil || len(metas) != 1 || len(metas[0].Snapshots) != 1 {
+		t.Fatalf("snapshot metadata not persisted: %+v %v", metas, err)
+	}
+
+	errResp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "restore_snapshot",
+		Arguments: map[string]any{"session_id": sessionID, "snapshot_id": 7},
+	})
+	if err != nil || !errResp.IsError {
+		t.Fatalf("expected unknown snapshot to fail, got %v %v", errResp, err)
+	}
+}
+
+func TestSessionLabelsAndFilteredListing(t *testing.T) {
+	srv, httpServer, _ := setupTestServer(t)
+	defer httpServer.Close()
+
+	dir := t.TempDir()
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+	conn, err := client.Connect(context.Background(), &mcp.StreamableClientTransport{Endpoint: httpServer.URL}, nil)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer conn.Close()
+	call := func(name string, args map[string]any) (map[string]any, bool) {
+		resp, err := conn.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		return decodeContent(t, resp), resp.IsError
+	}
+	open := func(name string, args map[string]any) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		args["path"] = path
+		payload, isErr := call("open_binary", args)
+		if isErr {
+			t.Fatalf("open_binary failed: %v", payload)
+		}
+		return payload["session_id"].(string)
+	}
+	libgame := open("libgame.so", map[string]any{
+		"label": "game core",
+		"owner": "agent-a",
+		"tags":  map[string]string{"project": "game", "arch": "arm64"},
+	})
+	libnet := open("libnet.so", map[string]any{"owner": "agent-b", "tags": map[string]string{"project": "game"}})
+	open("server.exe", map[string]any{})
+
+	listed := func(args map[string]any) []string {
+		payload, isErr := call("list_sessions", args)
+		if isErr {
+			t.Fatalf("list_sessions failed: %v", payload)
+		}
+		var ids []string
+		for _, entry := range payload["sessions"].([]any) {
+			ids = append(ids, entry.(map[string]any)["session_id"].(string))
+		}
+		return ids
+	}
+	cases := []struct {
+		args map[string]any
+		want []string
+	}{
+		{map[string]any{"owner": "agent-a"}, []string{libgame}},
+		{map[string]any{"tags": map[string]string{"project": "game", "arch": "arm64"}}, []string{libgame}},
+		{map[string]any{"tags": map[string]string{"arch": ""}}, []string{libgame}},
+		{map[string]any{"path_glob": "lib*.so", "owner": "agent-b"}, []string{libnet}},
+		{map[string]any{"path_glob": filepath.Join(dir, "*.exe"), "owner": "agent-a"}, nil},
+	}
+	for _, tc := range cases {
+		if got := listed(tc.args); !slices.Equal(got, tc.want) {
+			t.Errorf("list_sessions(%v) = %v, want %v", tc.args, got, tc.want)
+		}
+	}
+	if got := listed(map[string]any{"path_glob": "lib*.so"}); len(got) != 2 {
+		t.Errorf("path_glob lib*.so matched %v", got)
+	}
+	if payload, isErr := call("list_sessions", map[string]any{"path_glob": "[lib"}); !isErr || payload["kind"] != "invalid_input" {
+		t.Errorf("malformed glob accepted: %v", payload)
+	}
+
+	updated, isErr := call("update_session", map[string]any{
+		"session_id": libgame,
+		"label":      "game core (patched)",
+		"tags":       map[string]string{"arch": "", "status": "reviewed"},
+	})
+	if isErr {
+		t.Fatalf("update_session failed: %v", updated)
+	}
+	tags := updated["tags"].(map[string]any)
+	if updated["label"] != "game core (patched)" || updated["owner"] != "agent-a" || tags["arch"] != nil || tags["status"] != "reviewed" || tags["project"] != "game" {
+		t.Fatalf("unexpected labels after update: %v", updated)
+	}
+
+	metas, _, err := srv.store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, meta := range metas {
+		if meta.ID == libgame && (meta.Label != "game core (patched)" || meta.Tags["status"] != "reviewed") {
+			t.Fatalf("labels not persisted: %+v", meta)
+		}
+	}
+}
+
+func TestExportImportSessionBundle(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	dataDir := t.TempDir()
+	srv.SetDatabaseDirectory(dataDir)
+
+	binaryPath := filepath.Join(t.TempDir(), "libgame.so")
+	if err := os.WriteFile(binaryPath, []byte("\x7fELF game"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(binaryPath+".i64", []byte("analysed database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	identity, err := session.IdentifyFile(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, sourceID := openTestSession(t, httpServer.URL, binaryPath)
+	ctx := context.Background()
+	call := func(name string, args map[string]any) (map[string]any, bool) {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		return decodeContent(t, resp), resp.IsError
+	}
+	if payload, isErr := call("update_session", map[string]any{"session_id": sourceID, "label": "game", "tags": map[string]string{"team": "re"}}); isErr {
+		t.Fatalf("update_session failed: %v", payload)
+	}
+
+	exported, isErr := call("export_session", map[string]any{"session_id": sourceID})
+	if isErr {
+		t.Fatalf("export_session failed: %v", exported)
+	}
+	bundlePath, _ := exported["bundle_path"].(string)
+	if exported["sha256"] != identity.SHA256 || !strings.HasPrefix(bundlePath, filepath.Join(dataDir, "exports")) {
+		t.Fatalf("unexpected export result: %v", exported)
+	}
+
+	checkImported := func(payload map[string]any) string {
+		t.Helper()
+		importedID, _ := payload["session_id"].(string)
+		importedPath, _ := payload["binary_path"].(string)
+		if importedID == "" || importedID == sourceID || payload["source_session_id"] != sourceID || payload["sha256"] != identity.SHA256 {
+			t.Fatalf("unexpected import result: %v", payload)
+		}
+		if payload["label"] != "game" || payload["tags"].(map[string]any)["team"] != "re" {
+			t.Fatalf("labels not carried over: %v", payload)
+		}
+		if !strings.HasPrefix(importedPath, filepath.Join(dataDir, "imports")) {
+			t.Fatalf("imported database %s not under the database directory", importedPath)
+		}
+		if data, err := os.ReadFile(importedPath); err != nil || string(data) != "analysed database" {
+			t.Fatalf("imported database = %q, %v", data, err)
+		}
+		workers.mu.Lock()
+		started := workers.starts[importedPath]
+		workers.mu.Unlock()
+		if started != 1 {
+			t.Fatalf("imported session worker started %d times", started)
+		}
+		return importedID
+	}
+
+	imported, isErr := call("import_session", map[string]any{"bundle_path": bundlePath})
+	if isErr {
+		t.Fatalf("import_session failed: %v", imported)
+	}
+	importedID := checkImported(imported)
+
+	// The same round trip over the HTTP endpoints
+	resp, err := http.Get(httpServer.URL + "/sessions/" + sourceID + "/export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET export: status %d, %v", resp.StatusCode, err)
+	}
+	resp, err = http.Post(httpServer.URL+"/sessions/import", "application/gzip", strings.NewReader(string(bundle)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uploaded map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&uploaded); err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST import: status %d: %v", resp.StatusCode, uploaded)
+	}
+	checkImported(uploaded)
+
+	resp, err = http.Post(httpServer.URL+"/sessions/import", "application/gzip", strings.NewReader("not a bundle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("garbage bundle: status %d", resp.StatusCode)
+	}
+	resp, err = http.Get(httpServer.URL + "/sessions/missing/export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("export of unknown session: status %d", resp.StatusCode)
+	}
+
+	// Imports must fit in what is left of the storage quota
+	used, _ := storageUsage(srv.storageEntries())
+	srv.databaseQuota = used + 8
+	tooLarge, isErr := call("import_session", map[string]any{"bundle_path": bundlePath})
+	if !isErr || tooLarge["kind"] != "resource_limit_exceeded" {
+		t.Fatalf("expected an oversized bundle to be refused, got %v", tooLarge)
+	}
+	resp, err = http.Post(httpServer.URL+"/sessions/import", "application/gzip", strings.NewReader(string(bundle)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("oversized upload: status %d", resp.StatusCode)
+	}
+	if after, _ := storageUsage(srv.storageEntries()); after != used {
+		t.Fatalf("refused imports left %d bytes behind", after-used)
+	}
+	srv.databaseQuota = 0
+
+	// Closing an imported session removes its database
+	importedPath := imported["binary_path"].(string)
+	if payload, isErr := call("close_binary", map[string]any{"session_id": importedID}); isErr {
+		t.Fatalf("close_binary failed: %v", payload)
+	}
+	if _, err := os.Stat(filepath.Dir(importedPath)); !os.IsNotExist(err) {
+		t.Fatal("imported files were not removed")
+	}
+}
+
+func TestForkSessionAndMergeAnnotations(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+	dataDir := t.TempDir()
+	srv.SetDatabaseDirectory(dataDir)
+
+	binaryPath := filepath.Join(t.TempDir(), "target.so")
+	if err := os.WriteFile(binaryPath, []byte("\x7fELF"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(binaryPath+".i64", []byte("database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, parentID := openTestSession(t, httpServer.URL, binaryPath)
+	ctx := context.Background()
+	call := func(name string, args map[string]any) map[string]any {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		payload := decodeContent(t, resp)
+		if resp.IsError {
+			t.Fatalf("%s failed: %v", name, payload)
+		}
+		return payload
+	}
+
+	forked := call("fork_session", map[string]any{"session_id": parentID})
+	forkID, _ := forked["session_id"].(string)
+	forkBinary, _ := forked["binary_path"].(string)
+	if forkID == "" || forkID == parentID || forked["parent_id"] != parentID {
+		t.Fatalf("unexpected fork result: %v", forked)
+	}
+	if !strings.HasPrefix(forkBinary, filepath.Join(dataDir, "forks")) {
+		t.Fatalf("fork binary %s not under the database directory", forkBinary)
+	}
+	if data, err := os.ReadFile(forkBinary + ".i64"); err != nil || string(data) != "database" {
+		t.Fatalf("fork database not copied: %q %v", data, err)
+	}
+	// The fork's worker opens the copy by path, not by naming convention
+	workers.mu.Lock()
+	forkWorker := workers.sessions[forkID]
+	workers.mu.Unlock()
+	forkWorker.mu.Lock()
+	opened := forkWorker.databasePath
+	forkWorker.mu.Unlock()
+	if opened != forkBinary+".i64" {
+		t.Fatalf("fork worker opened database %q, expected the copy", opened)
+	}
+
+	// Speculative changes land in the fork only
+	const renamed, commented, untouched = 0x1000, 0x2000, 0x3000
+	call("set_name", map[string]any{"session_id": forkID, "address": renamed, "name": "parse_header"})
+	call("set_function_type", map[string]any{"session_id": forkID, "address": renamed, "prototype": "int parse_header(char *buf)"})
+	call("set_comment", map[string]any{"session_id": forkID, "address": commented, "comment": "magic check"})
+	call("set_name", map[string]any{"session_id": forkID, "address": untouched, "name": "scratch"})
+
+	workers.mu.Lock()
+	parent := workers.sessions[parentID]
+	workers.mu.Unlock()
+	if parent.annotation(&parent.names, renamed) != "" {
+		t.Fatal("fork changes leaked into the parent")
+	}
+
+	merged := call("merge_session_annotations", map[string]any{
+		"session_id": forkID,
+		"addresses":  []uint64{renamed, commented},
+	})
+	if merged["merged"] != float64(3) {
+		t.Fatalf("expected 3 merged annotations, got %v", merged)
+	}
+	if got := parent.annotation(&parent.names, renamed); got != "parse_header" {
+		t.Fatalf("parent name = %q", got)
+	}
+	if got := parent.annotation(&parent.types, renamed); got != "int parse_header(char *buf)" {
+		t.Fatalf("parent type = %q", got)
+	}
+	if got := parent.annotation(&parent.comments, commented); got != "magic check" {
+		t.Fatalf("parent comment = %q", got)
+	}
+	if parent.annotation(&parent.names, untouched) != "" {
+		t.Fatal("unchosen address was merged")
+	}
+
+	// Each merged change is journaled on the parent under its own tool, so
+	// it can be undone there
+	stack := call("list_undo_stack", map[string]any{"session_id": parentID})
+	undoStack, _ := stack["undo"].([]any)
+	var tools []string
+	for _, entry := range undoStack {
+		tools = append(tools, entry.(map[string]any)["tool"].(string))
+	}
+	if strings.Join(tools, ",") != "set_comment,set_function_type,set_name" {
+		t.Fatalf("unexpected parent undo stack: %v", stack)
+	}
+	if undone := call("undo", map[string]any{"session_id": parentID}); undone["undone"] != float64(1) {
+		t.Fatalf("unexpected undo of the merged comment: %v", undone)
+	}
+	if got := parent.annotation(&parent.comments, commented); got != "" {
+		t.Fatalf("merged comment not undone: %q", got)
+	}
+
+	// Closing the fork removes its copy
+	call("close_binary", map[string]any{"session_id": forkID})
+	if _, err := os.Stat(filepath.Dir(forkBinary)); !os.IsNotExist(err) {
+		t.Fatal("fork files were not removed")
+	}
+}
+
+func TestRestoredSessionsStartOnFirstUse(t *testing.T) {
+	srv, httpServer, workers := setupTestServer(t)
+	defer httpServer.Close()
+
+	// More saved sessions than max_concurrent_sessions (4)
+	saved := session.NewRegistry(0)
+	dir := t.TempDir()
+	var ids []string
+	for i := range 5 {
+		sess, err := saved.Create(filepath.Join(dir, fmt.Sprintf("saved-%d.bin", i)), time.Minute)
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		if err := srv.store.Save(sess); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+		ids = append(ids, sess.ID)
+	}
+	srv.RestoreSessions()
+
+	workers.mu.Lock()
+	started := len(workers.starts)
+	workers.mu.Unlock()
+	if started != 0 {
+		t.Fatalf("restore started %d workers, expected none", started)
+	}
+
+	ctx := context.Background()
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+	conn, err := client.Connect(ctx, &mcp.StreamableClientTransport{Endpoint: httpServer.URL}, nil)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer conn.Close()
+	listSessions := func() map[string]any {
+		resp, err := conn.CallTool(ctx, &mcp.CallToolParams{Name: "list_sessions"})
+		if err != nil {
+			t.Fatalf("list_sessions: %v", err)
+		}
+		return decodeContent(t, resp)
+	}
+	if payload := listSessions(); payload["count"] != float64(0) || payload["dormant_count"] != float64(5) {
+		t.Fatalf("expected 5 dormant sessions, got %v", payload)
+	}
+
+	resp, err := conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "get_functions",
+		Arguments: map[string]any{"session_id": ids[0]},
+	})
+	if err != nil {
+		t.Fatalf("get_functions: %v", err)
+	}
+	if resp.IsError {
+		t.Fatalf("get_functions on dormant session failed: %v", decodeContent(t, resp))
+	}
+	if payload := listSessions(); payload["count"] != float64(1) || payload["dormant_count"] != float64(4) {
+		t.Fatalf("expected 1 active and 4 dormant sessions, got %v", payload)
+	}
+
+	// Closing a dormant session does not start its worker
+	resp, err = conn.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "close_binary",
+		Arguments: map[string]any{"session_id": ids[1]},
+	})
+	if err != nil || resp.IsError {
+		t.Fatalf("close_binary on dormant session: %v %v", err, resp)
+	}
+	workers.mu.Lock()
+	started = len(workers.starts)
+	workers.mu.Unlock()
+	if started != 1 {
+		t.Fatalf("expected only the used session to start a worker, got %d", started)
+	}
+}
+
 func TestGetStringsRegexFiltering(t *testing.T) {
 	httpServer, _ := setupTestMCPServer(t)
 	defer httpServer.Close()
@@ -660,6 +2605,12 @@ func TestMakeFunction(t *testing.T) {
 
 func setupTestMCPServer(t *testing.T) (*httptest.Server, *fakeWorkerManager) {
 	t.Helper()
+	_, httpServer, workers := setupTestServer(t)
+	return httpServer, workers
+}
+
+func setupTestServer(t *testing.T) (*Server, *httptest.Server, *fakeWorkerManager) {
+	t.Helper()
 
 	logger := log.New(io.Discard, "", 0)
 	registry := session.NewRegistry(4)
@@ -685,7 +2636,7 @@ func setupTestMCPServer(t *testing.T) (*httptest.Server, *fakeWorkerManager) {
 
 	srv.RegisterTools(mcpServer)
 	handler := srv.HTTPMux(mcpServer)
-	return newIPv4HTTPServer(t, handler), workers
+	return srv, newIPv4HTTPServer(t, handler), workers
 }
 
 func runLifecycleScenario(t *testing.T, transport mcp.Transport) {
@@ -836,6 +2787,17 @@ type fakeWorkerManager struct {
 	mu       sync.Mutex
 	sessions map[string]*fakeWorker
 	starts   map[string]int
+	limited  map[string]string
+	killed   map[string]string
+	logs     map[string][]worker.LogEntry
+	logQuery worker.LogQuery
+	// startDelay and startErr simulate slow or failing worker starts
+	startDelay time.Duration
+	startErr   error
+	// analysisGate, when set, holds PlanAndWait until it is closed
+	analysisGate chan struct{}
+	// noDecompiler makes workers open databases without the decompiler
+	noDecompiler bool
 }
 
 func newFakeWorkerManager(t *testing.T) *fakeWorkerManager {
@@ -843,6 +2805,9 @@ func newFakeWorkerManager(t *testing.T) *fakeWorkerManager {
 		t:        t,
 		sessions: make(map[string]*fakeWorker),
 		starts:   make(map[string]int),
+		limited:  make(map[string]string),
+		killed:   make(map[string]string),
+		logs:     make(map[string][]worker.LogEntry),
 	}
 }
 
@@ -851,14 +2816,57 @@ type fakeWorker struct {
 	server    *httptest.Server
 	client    *worker.WorkerClient
 
-	mu         sync.Mutex
-	binaryPath string
-	closed     bool
+	mu           sync.Mutex
+	binaryPath   string
+	databasePath string
+	closed       bool
 	analyzed   bool
+	saves      int
+	gate       chan struct{}
+	// stuck makes GetDecompiled hang until the call is abandoned
+	stuck bool
+	// Request ID of the hung call, and those SessionControl.Cancel received
+	stuckID string
+	cancels []string
+	// cancelMisses makes Cancel report that no such call is running
+	cancelMisses bool
+	// dirty is the worker's own unsaved-changes flag, cleared by a save
+	dirty bool
+	// statusAt, when set, is when the worker's last status was received
+	statusAt time.Time
+	noDecompiler bool
+	// Annotations by address, as set through the write RPCs
+	names    map[uint64]string
+	comments map[uint64]string
+	types    map[uint64]string
+}
+
+func (w *fakeWorker) annotate(m *map[uint64]string, addr uint64, value string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if *m == nil {
+		*m = make(map[uint64]string)
+	}
+	(*m)[addr] = value
+}
+
+func (w *fakeWorker) annotation(m *map[uint64]string, addr uint64) string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return (*m)[addr]
 }
 
 func (f *fakeWorkerManager) Start(_ context.Context, sess *session.Session, binaryPath string) error {
-	fake := &fakeWorker{sessionID: sess.ID, binaryPath: binaryPath}
+	f.mu.Lock()
+	delay, startErr, gate, noDecompiler := f.startDelay, f.startErr, f.analysisGate, f.noDecompiler
+	f.starts[binaryPath]++
+	f.mu.Unlock()
+	time.Sleep(delay)
+	if startErr != nil {
+		return startErr
+	}
+
+	fake := &fakeWorker{sessionID: sess.ID, binaryPath: binaryPath, gate: gate, noDecompiler: noDecompiler}
 
 	sessionSvc := &fakeSessionControlServer{worker: fake}
 	analysisSvc := &fakeAnalysisServer{worker: fake}
@@ -876,9 +2884,10 @@ func (f *fakeWorkerManager) Start(_ context.Context, sess *session.Session, bina
 
 	httpClient := server.Client()
 	baseURL := server.URL
-	sessionClient := workerconnect.NewSessionControlClient(httpClient, baseURL)
-	analysisClient := workerconnect.NewAnalysisToolsClient(httpClient, baseURL)
-	healthClient := workerconnect.NewHealthcheckClient(httpClient, baseURL)
+	interceptors := connect.WithInterceptors(worker.RequestIDInterceptor())
+	sessionClient := workerconnect.NewSessionControlClient(httpClient, baseURL, interceptors)
+	analysisClient := workerconnect.NewAnalysisToolsClient(httpClient, baseURL, interceptors)
+	healthClient := workerconnect.NewHealthcheckClient(httpClient, baseURL, interceptors)
 
 	fake.server = server
 	fake.client = &worker.WorkerClient{
@@ -890,7 +2899,6 @@ func (f *fakeWorkerManager) Start(_ context.Context, sess *session.Session, bina
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	f.sessions[sess.ID] = fake
-	f.starts[binaryPath]++
 	return nil
 }
 
@@ -911,13 +2919,92 @@ func (f *fakeWorkerManager) GetClient(sessionID string) (*worker.WorkerClient, e
 	defer f.mu.Unlock()
 	fake, ok := f.sessions[sessionID]
 	if !ok {
+		if reason, limited := f.limited[sessionID]; limited {
+			return nil, fmt.Errorf("session %s: %w: %s", sessionID, worker.ErrResourceLimit, reason)
+		}
 		return nil, fmt.Errorf("no worker for session %s", sessionID)
 	}
 	return fake.client, nil
 }
 
+// KillForLimit simulates the manager killing a worker over its memory limit.
+func (f *fakeWorkerManager) KillForLimit(sessionID, reason string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if fake, ok := f.sessions[sessionID]; ok {
+		fake.server.Close()
+		delete(f.sessions, sessionID)
+	}
+	f.limited[sessionID] = reason
+}
+
+// Kill simulates killing a stuck worker: crash recovery starts a fresh one
+// under the same session.
+func (f *fakeWorkerManager) Kill(sessionID, reason string) error {
+	f.mu.Lock()
+	fake, ok := f.sessions[sessionID]
+	if ok {
+		delete(f.sessions, sessionID)
+		f.killed[sessionID] = reason
+	}
+	f.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no worker for session %s", sessionID)
+	}
+	fake.server.Close()
+	return f.Start(context.Background(), &session.Session{ID: sessionID}, fake.binaryPath)
+}
+
+func (f *fakeWorkerManager) KillReason(sessionID string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.killed[sessionID]
+}
+
 func (f *fakeWorkerManager) CleanupOrphanSockets() int    { return 0 }
 func (f *fakeWorkerManager) CleanupOrphanProcesses() int  { return 0 }
+func (f *fakeWorkerManager) LocalFilesystem() bool        { return true }
+
+func (f *fakeWorkerManager) Info(sessionID string) worker.Info {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fw, ok := f.sessions[sessionID]
+	if !ok {
+		return worker.Info{LimitExceeded: f.limited[sessionID]}
+	}
+	fw.mu.Lock()
+	dirty, statusAt := fw.dirty, fw.statusAt
+	fw.mu.Unlock()
+	if statusAt.IsZero() {
+		statusAt = time.Now()
+	}
+	return worker.Info{Running: true, Health: &worker.Health{MemoryBytes: 64 << 20, Dirty: dirty, PendingRequests: 1, StatusAt: statusAt}}
+}
+
+func (f *fakeWorkerManager) PoolStats() worker.PoolStats { return worker.PoolStats{} }
+
+func (f *fakeWorkerManager) Logs(sessionID string, query worker.LogQuery) []worker.LogEntry {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.logQuery = query
+	entries := f.logs[sessionID]
+	if query.Tail > 0 && len(entries) > query.Tail {
+		entries = entries[len(entries)-query.Tail:]
+	}
+	return entries
+}
+
+func (f *fakeWorkerManager) SaveCount(sessionID string) int {
+	f.mu.Lock()
+	fake, ok := f.sessions[sessionID]
+	f.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.saves
+}
 
 func (f *fakeWorkerManager) StartCount(binaryPath string) int {
 	f.mu.Lock()
@@ -932,12 +3019,16 @@ type fakeSessionControlServer struct {
 func (f *fakeSessionControlServer) OpenBinary(_ context.Context, req *connect.Request[pb.OpenBinaryRequest]) (*connect.Response[pb.OpenBinaryResponse], error) {
 	f.worker.mu.Lock()
 	f.worker.binaryPath = req.Msg.GetBinaryPath()
+	f.worker.databasePath = req.Msg.GetDatabasePath()
 	f.worker.closed = false
+	hasDecompiler := !f.worker.noDecompiler
 	f.worker.mu.Unlock()
 	return connect.NewResponse(&pb.OpenBinaryResponse{
 		Success:       true,
-		HasDecompiler: true,
+		HasDecompiler: hasDecompiler,
 		BinaryPath:    req.Msg.GetBinaryPath(),
+		Processor:     "metapc",
+		Bitness:       64,
 	}), nil
 }
 
@@ -948,7 +3039,14 @@ func (f *fakeSessionControlServer) CloseSession(_ context.Context, _ *connect.Re
 	return connect.NewResponse(&pb.CloseSessionResponse{Success: true}), nil
 }
 
-func (f *fakeSessionControlServer) PlanAndWait(_ context.Context, _ *connect.Request[pb.PlanAndWaitRequest]) (*connect.Response[pb.PlanAndWaitResponse], error) {
+func (f *fakeSessionControlServer) PlanAndWait(ctx context.Context, _ *connect.Request[pb.PlanAndWaitRequest]) (*connect.Response[pb.PlanAndWaitResponse], error) {
+	if f.worker.gate != nil {
+		select {
+		case <-f.worker.gate:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 	f.worker.mu.Lock()
 	f.worker.analyzed = true
 	f.worker.mu.Unlock()
@@ -959,6 +3057,10 @@ func (f *fakeSessionControlServer) PlanAndWait(_ context.Context, _ *connect.Req
 }
 
 func (f *fakeSessionControlServer) SaveDatabase(_ context.Context, _ *connect.Request[pb.SaveDatabaseRequest]) (*connect.Response[pb.SaveDatabaseResponse], error) {
+	f.worker.mu.Lock()
+	f.worker.saves++
+	f.worker.dirty = false
+	f.worker.mu.Unlock()
 	return connect.NewResponse(&pb.SaveDatabaseResponse{
 		Success:   true,
 		Timestamp: time.Now().Unix(),
@@ -977,6 +3079,16 @@ func (f *fakeSessionControlServer) GetSessionInfo(_ context.Context, _ *connect.
 	}), nil
 }
 
+// Cancel finds the hung call but cannot stop it, as decompilation has no
+// safe point.
+func (f *fakeSessionControlServer) Cancel(_ context.Context, req *connect.Request[pb.CancelRequest]) (*connect.Response[pb.CancelResponse], error) {
+	f.worker.mu.Lock()
+	defer f.worker.mu.Unlock()
+	f.worker.cancels = append(f.worker.cancels, req.Msg.RequestId)
+	found := !f.worker.cancelMisses && req.Msg.RequestId == f.worker.stuckID
+	return connect.NewResponse(&pb.CancelResponse{Found: found}), nil
+}
+
 type fakeAnalysisServer struct {
 	workerconnect.UnimplementedAnalysisToolsHandler
 	worker *fakeWorker
@@ -1002,8 +3114,12 @@ func (f *fakeAnalysisServer) GetFunctions(_ context.Context, _ *connect.Request[
 	}), nil
 }
 
-func (f *fakeAnalysisServer) GetBytes(context.Context, *connect.Request[pb.GetBytesRequest]) (*connect.Response[pb.GetBytesResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+func (f *fakeAnalysisServer) GetBytes(_ context.Context, req *connect.Request[pb.GetBytesRequest]) (*connect.Response[pb.GetBytesResponse], error) {
+	data := make([]byte, req.Msg.Size)
+	for i := range data {
+		data[i] = byte(req.Msg.Address) + byte(i)
+	}
+	return connect.NewResponse(&pb.GetBytesResponse{Data: data}), nil
 }
 
 func (f *fakeAnalysisServer) GetDisasm(context.Context, *connect.Request[pb.GetDisasmRequest]) (*connect.Response[pb.GetDisasmResponse], error) {
@@ -1014,16 +3130,60 @@ func (f *fakeAnalysisServer) GetFunctionDisasm(context.Context, *connect.Request
 	return connect.NewResponse(&pb.GetFunctionDisasmResponse{Disassembly: "deadbeef: mov x0, x0"}), nil
 }
 
-func (f *fakeAnalysisServer) GetDecompiled(context.Context, *connect.Request[pb.GetDecompiledRequest]) (*connect.Response[pb.GetDecompiledResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+func (f *fakeAnalysisServer) GetDecompiled(ctx context.Context, req *connect.Request[pb.GetDecompiledRequest]) (*connect.Response[pb.GetDecompiledResponse], error) {
+	f.worker.mu.Lock()
+	stuck := f.worker.stuck
+	if stuck {
+		f.worker.stuckID = req.Header().Get(worker.RequestIDHeader)
+	}
+	f.worker.mu.Unlock()
+	if !stuck {
+		return connect.NewResponse(&pb.GetDecompiledResponse{Code: fmt.Sprintf("void sub_%x(void) {}", req.Msg.Address)}), nil
+	}
+	<-ctx.Done()
+	return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
 }
 
-func (f *fakeAnalysisServer) SetName(context.Context, *connect.Request[pb.SetNameRequest]) (*connect.Response[pb.SetNameResponse], error) {
+func (f *fakeAnalysisServer) SetName(_ context.Context, req *connect.Request[pb.SetNameRequest]) (*connect.Response[pb.SetNameResponse], error) {
+	f.worker.annotate(&f.worker.names, req.Msg.Address, req.Msg.Name)
 	resp := &pb.SetNameResponse{Success: true}
 	return connect.NewResponse(resp), nil
 }
 
-func (f *fakeAnalysisServer) SetFunctionType(context.Context, *connect.Request[pb.SetFunctionTypeRequest]) (*connect.Response[pb.SetFunctionTypeResponse], error) {
+func (f *fakeAnalysisServer) GetName(_ context.Context, req *connect.Request[pb.GetNameRequest]) (*connect.Response[pb.GetNameResponse], error) {
+	return connect.NewResponse(&pb.GetNameResponse{Name: f.worker.annotation(&f.worker.names, req.Msg.Address)}), nil
+}
+
+func (f *fakeAnalysisServer) SetComment(_ context.Context, req *connect.Request[pb.SetCommentRequest]) (*connect.Response[pb.SetCommentResponse], error) {
+	if !req.Msg.Repeatable {
+		f.worker.annotate(&f.worker.comments, req.Msg.Address, req.Msg.Comment)
+	}
+	return connect.NewResponse(&pb.SetCommentResponse{Success: true}), nil
+}
+
+func (f *fakeAnalysisServer) GetComment(_ context.Context, req *connect.Request[pb.GetCommentRequest]) (*connect.Response[pb.GetCommentResponse], error) {
+	if req.Msg.Repeatable {
+		return connect.NewResponse(&pb.GetCommentResponse{}), nil
+	}
+	return connect.NewResponse(&pb.GetCommentResponse{Comment: f.worker.annotation(&f.worker.comments, req.Msg.Address)}), nil
+}
+
+func (f *fakeAnalysisServer) GetFuncComment(context.Context, *connect.Request[pb.GetFuncCommentRequest]) (*connect.Response[pb.GetFuncCommentResponse], error) {
+	return connect.NewResponse(&pb.GetFuncCommentResponse{}), nil
+}
+
+func (f *fakeAnalysisServer) GetTypeAt(_ context.Context, req *connect.Request[pb.GetTypeAtRequest]) (*connect.Response[pb.GetTypeAtResponse], error) {
+	typ := f.worker.annotation(&f.worker.types, req.Msg.Address)
+	return connect.NewResponse(&pb.GetTypeAtResponse{
+		Address: req.Msg.Address,
+		Type:    typ,
+		HasType: typ != "",
+		IsFunc:  typ != "",
+	}), nil
+}
+
+func (f *fakeAnalysisServer) SetFunctionType(_ context.Context, req *connect.Request[pb.SetFunctionTypeRequest]) (*connect.Response[pb.SetFunctionTypeResponse], error) {
+	f.worker.annotate(&f.worker.types, req.Msg.Address, req.Msg.Prototype)
 	resp := &pb.SetFunctionTypeResponse{Success: true}
 	return connect.NewResponse(resp), nil
 }
@@ -1111,7 +3271,8 @@ func (f *fakeAnalysisServer) SetGlobalType(context.Context, *connect.Request[pb.
 	return connect.NewResponse(resp), nil
 }
 
-func (f *fakeAnalysisServer) RenameGlobal(context.Context, *connect.Request[pb.RenameGlobalRequest]) (*connect.Response[pb.RenameGlobalResponse], error) {
+func (f *fakeAnalysisServer) RenameGlobal(_ context.Context, req *connect.Request[pb.RenameGlobalRequest]) (*connect.Response[pb.RenameGlobalResponse], error) {
+	f.worker.annotate(&f.worker.names, req.Msg.Address, req.Msg.NewName)
 	resp := &pb.RenameGlobalResponse{Success: true}
 	return connect.NewResponse(resp), nil
 }
@@ -1228,8 +3389,9 @@ func (f *fakeAnalysisServer) GetInstructionLength(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
 }
 
-func (f *fakeAnalysisServer) DeleteName(context.Context, *connect.Request[pb.DeleteNameRequest]) (*connect.Response[pb.DeleteNameResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+func (f *fakeAnalysisServer) DeleteName(_ context.Context, req *connect.Request[pb.DeleteNameRequest]) (*connect.Response[pb.DeleteNameResponse], error) {
+	f.worker.annotate(&f.worker.names, req.Msg.Address, "")
+	return connect.NewResponse(&pb.DeleteNameResponse{Success: true}), nil
 }
 
 type fakeHealthServer struct{}
@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxUndoDepth bounds the changes kept for undo per session; the oldest
+// drop off.
+const maxUndoDepth = 1000
+
+// Outcomes of an undone or redone change
+const (
+	historyUndone  = "undone"
+	historyRedone  = "redone"
+	historyFailed  = "failed"
+	historyDropped = "dropped" // irreversible, removed by skip_irreversible
+)
+
+// undoEntry is one change on a session's undo or redo stack.
+type undoEntry struct {
+	Seq     int64     `json:"seq"` // numbers the session's changes since it opened
+	Time    time.Time `json:"time"`
+	Tool    string    `json:"tool"`
+	Address uint64    `json:"address"`
+	Prior   *string   `json:"prior,omitempty"`
+	Value   string    `json:"value"`
+	// Undoable is false when no RPC can restore the prior state; Reason
+	// says why
+	Undoable bool   `json:"undoable"`
+	Reason   string `json:"reason,omitempty"`
+
+	redo   ReplayStep
+	revert ReplayStep
+}
+
+// undoHistory holds a session's undo and redo stacks, most recent last.
+// Its lock is held while undo or redo applies changes, so concurrent
+// changes wait and the stacks stay in order.
+type undoHistory struct {
+	mu   sync.Mutex
+	undo []undoEntry
+	redo []undoEntry
+	seq  int64
+}
+
+// historyOutcome reports what happened to one change.
+type historyOutcome struct {
+	Seq     int64  `json:"seq"`
+	Tool    string `json:"tool"`
+	Address uint64 `json:"address"`
+	Status  string `json:"status"`
+	// Value written by undo or redo
+	Value  string `json:"value"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// replayingHistory marks changes made by undo and redo, which move entries
+// between the stacks themselves rather than record new ones.
+type replayingHistory struct{}
+
+func (s *Server) sessionHistory(sessionID string) *undoHistory {
+	s.historyMu.Lock()
+	defer s.historyMu.Unlock()
+	if s.history == nil {
+		s.history = make(map[string]*undoHistory)
+	}
+	h := s.history[sessionID]
+	if h == nil {
+		h = &undoHistory{}
+		s.history[sessionID] = h
+	}
+	return h
+}
+
+// clearHistory forgets a session's undo and redo stacks, once its database
+// no longer holds the changes they describe.
+func (s *Server) clearHistory(sessionID string) {
+	s.historyMu.Lock()
+	defer s.historyMu.Unlock()
+	delete(s.history, sessionID)
+}
+
+// revertStep returns the step that restores the state a change replaced,
+// or why there is none.
+func revertStep(step ReplayStep, prior *string) (ReplayStep, string) {
+	revert := step
+	revert.Prior = step.Value
+	switch step.Tool {
+	case "set_name", "rename_global", "delete_name":
+		switch {
+		case prior == nil:
+			return revert, "the prior name could not be read"
+		case *prior == "" || isGeneratedName(*prior):
+			revert.Tool = "delete_name"
+			revert.Value = ""
+		case step.Tool == "delete_name":
+			revert.Tool = "set_name"
+			revert.Value = *prior
+		default:
+			revert.Value = *prior
+		}
+	case "set_comment", "set_func_comment":
+		if prior == nil {
+			return revert, "the prior comment could not be read"
+		}
+		revert.Value = *prior
+	case "set_global_type", "set_function_type":
+		switch {
+		case prior == nil:
+			return revert, "the prior type could not be read"
+		case *prior == "":
+			return revert, "no type was set before and IDA cannot clear one"
+		}
+		revert.Value = *prior
+	case "rename_lvar":
+		revert.LvarName = step.Value
+		revert.Value = step.LvarName
+	case "set_lvar_type":
+		return revert, "no RPC reads a local variable's type"
+	case "set_decompiler_comment":
+		return revert, "no RPC reads decompiler comments"
+	case "make_function":
+		return revert, "no RPC deletes a function"
+	default:
+		return revert, fmt.Sprintf("%s cannot be undone", step.Tool)
+	}
+	return revert, ""
+}
+
+// recordUndo pushes a change onto the session's undo stack and clears its
+// redo stack.
+func (s *Server) recordUndo(ctx context.Context, sessionID string, entry journalEntry) {
+	if ctx.Value(replayingHistory{}) != nil {
+		return
+	}
+	redo := journalStep(entry, "")
+	revert, reason := revertStep(redo, entry.Prior)
+
+	h := s.sessionHistory(sessionID)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.seq++
+	h.undo = append(h.undo, undoEntry{
+		Seq:      h.seq,
+		Time:     entry.Time,
+		Tool:     entry.Tool,
+		Address:  entry.Address,
+		Prior:    entry.Prior,
+		Value:    entry.Value,
+		Undoable: reason == "",
+		Reason:   reason,
+		redo:     redo,
+		revert:   revert,
+	})
+	if len(h.undo) > maxUndoDepth {
+		h.undo = h.undo[len(h.undo)-maxUndoDepth:]
+	}
+	h.redo = nil
+}
+
+func historyCount(count int) (int, error) {
+	if count == 0 {
+		return 1, nil
+	}
+	if count < 0 || count > maxUndoDepth {
+		return 0, fmt.Errorf("count must be between 1 and %d", maxUndoDepth)
+	}
+	return count, nil
+}
+
+func historyResult(sessionID string, h *undoHistory, key string, done int, outcomes []historyOutcome, stopped string) map[string]any {
+	result := map[string]any{
+		"session_id": sessionID,
+		key:          done,
+		"changes":    outcomes,
+		"undo_depth": len(h.undo),
+		"redo_depth": len(h.redo),
+	}
+	if stopped != "" {
+		result["stopped"] = stopped
+	}
+	return result
+}
+
+func (s *Server) undo(ctx context.Context, req *mcp.CallToolRequest, args UndoRequest) (*mcp.CallToolResult, any, error) {
+	const op = "undo"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"count": args.Count, "skip_irreversible": args.SkipIrreversible})
+	count, err := historyCount(args.Count)
+	if err != nil {
+		return s.handleToolError(invalidInput(op, err.Error()))
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	h := s.sessionHistory(sess.ID)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ctx = context.WithValue(ctx, replayingHistory{}, true)
+	outcomes := make([]historyOutcome, 0, count)
+	var undone int
+	var stopped string
+	for undone < count && len(h.undo) > 0 {
+		entry := h.undo[len(h.undo)-1]
+		outcome := historyOutcome{Seq: entry.Seq, Tool: entry.Tool, Address: entry.Address, Value: entry.revert.Value}
+		if !entry.Undoable {
+			if !args.SkipIrreversible {
+				stopped = fmt.Sprintf("change %d (%s at 0x%x) cannot be undone: %s", entry.Seq, entry.Tool, entry.Address, entry.Reason)
+				break
+			}
+			h.undo = h.undo[:len(h.undo)-1]
+			outcome.Status = historyDropped
+			outcome.Value = ""
+			outcome.Reason = entry.Reason
+			outcomes = append(outcomes, outcome)
+			continue
+		}
+		if reason := toolFailure(s.applyReplayStep(ctx, req, sess.ID, entry.revert.Address, entry.revert)); reason != "" {
+			outcome.Status = historyFailed
+			outcome.Reason = reason
+			outcomes = append(outcomes, outcome)
+			stopped = fmt.Sprintf("change %d (%s at 0x%x) could not be undone: %s", entry.Seq, entry.Tool, entry.Address, reason)
+			break
+		}
+		h.undo = h.undo[:len(h.undo)-1]
+		h.redo = append(h.redo, entry)
+		outcome.Status = historyUndone
+		outcomes = append(outcomes, outcome)
+		undone++
+	}
+	if undone > 0 {
+		s.logger.Printf("[Undo] Session %s: reverted %d changes", sess.ID, undone)
+	}
+
+	jsonResult, _ := s.marshalJSON(historyResult(sess.ID, h, "undone", undone, outcomes, stopped))
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) redo(ctx context.Context, req *mcp.CallToolRequest, args RedoRequest) (*mcp.CallToolResult, any, error) {
+	const op = "redo"
+	s.logToolInvocation(op, args.SessionID, map[string]interface{}{"count": args.Count})
+	count, err := historyCount(args.Count)
+	if err != nil {
+		return s.handleToolError(invalidInput(op, err.Error()))
+	}
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	h := s.sessionHistory(sess.ID)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ctx = context.WithValue(ctx, replayingHistory{}, true)
+	outcomes := make([]historyOutcome, 0, count)
+	var redone int
+	var stopped string
+	for redone < count && len(h.redo) > 0 {
+		entry := h.redo[len(h.redo)-1]
+		outcome := historyOutcome{Seq: entry.Seq, Tool: entry.Tool, Address: entry.Address, Value: entry.redo.Value}
+		if reason := toolFailure(s.applyReplayStep(ctx, req, sess.ID, entry.redo.Address, entry.redo)); reason != "" {
+			outcome.Status = historyFailed
+			outcome.Reason = reason
+			outcomes = append(outcomes, outcome)
+			stopped = fmt.Sprintf("change %d (%s at 0x%x) could not be redone: %s", entry.Seq, entry.Tool, entry.Address, reason)
+			break
+		}
+		h.redo = h.redo[:len(h.redo)-1]
+		h.undo = append(h.undo, entry)
+		outcome.Status = historyRedone
+		outcomes = append(outcomes, outcome)
+		redone++
+	}
+	if redone > 0 {
+		s.logger.Printf("[Undo] Session %s: re-applied %d changes", sess.ID, redone)
+	}
+
+	jsonResult, _ := s.marshalJSON(historyResult(sess.ID, h, "redone", redone, outcomes, stopped))
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
+
+func (s *Server) listUndoStack(ctx context.Context, req *mcp.CallToolRequest, args ListUndoStackRequest) (*mcp.CallToolResult, any, error) {
+	const op = "list_undo_stack"
+	s.logToolInvocation(op, args.SessionID, nil)
+	sess, ok := s.registry.Get(args.SessionID)
+	if !ok {
+		return s.handleToolError(sessionNotFound(op, args.SessionID))
+	}
+	sess.Touch()
+
+	h := s.sessionHistory(sess.ID)
+	h.mu.Lock()
+	// Both listed in the order undo and redo would take them
+	undo := make([]undoEntry, 0, len(h.undo))
+	for i := len(h.undo) - 1; i >= 0; i-- {
+		undo = append(undo, h.undo[i])
+	}
+	redo := make([]undoEntry, 0, len(h.redo))
+	for i := len(h.redo) - 1; i >= 0; i-- {
+		redo = append(redo, h.redo[i])
+	}
+	h.mu.Unlock()
+
+	jsonResult, _ := s.marshalJSON(map[string]any{
+		"session_id": sess.ID,
+		"undo":       undo,
+		"redo":       redo,
+		"max_depth":  maxUndoDepth,
+	})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonResult)},
+		},
+	}, nil, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/zboralski/ida-headless-mcp/internal/worker"
 )
 
 // logAndSanitizeError logs the full error server-side and returns a sanitized error for the MCP client.
@@ -18,6 +19,15 @@ func (s *Server) logAndSanitizeError(context string, err error) error {
 // handleToolError logs the structured ToolError and returns an MCP CallToolResult with
 // the serialised JSON body, so MCP clients can programmatically recover using the kind/status fields.
 func (s *Server) handleToolError(terr *ToolError) (*mcp.CallToolResult, any, error) {
+	// A call that failed because its worker was killed by its limits reports
+	// that, not the broken connection it observed
+	if terr.Kind == ErrIDAOperation || terr.Kind == ErrWorkerUnavailable {
+		if sessionID, _ := terr.Context["session_id"].(string); sessionID != "" {
+			if reason := s.workers.Info(sessionID).LimitExceeded; reason != "" {
+				terr = resourceLimitExceeded(terr.Operation, sessionID, fmt.Errorf("%w: %s", worker.ErrResourceLimit, reason))
+			}
+		}
+	}
 	s.logger.Printf("[Error] %s", terr.Error())
 	body, _ := s.marshalJSON(terr)
 	return &mcp.CallToolResult{
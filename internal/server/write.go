@@ -22,6 +22,7 @@ func (s *Server) setComment(ctx context.Context, req *mcp.CallToolRequest, args
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readComment(ctx, client, args.Address, args.Repeatable)
 	resp, err := (*client.Analysis).SetComment(ctx, connect.NewRequest(&pb.SetCommentRequest{
 		Address:    args.Address,
 		Comment:    args.Comment,
@@ -33,6 +34,10 @@ func (s *Server) setComment(ctx context.Context, req *mcp.CallToolRequest, args
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.Comment)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -49,6 +54,7 @@ func (s *Server) setFuncComment(ctx context.Context, req *mcp.CallToolRequest, a
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readFuncComment(ctx, client, args.Address)
 	resp, err := (*client.Analysis).SetFuncComment(ctx, connect.NewRequest(&pb.SetFuncCommentRequest{
 		Address: args.Address,
 		Comment: args.Comment,
@@ -59,6 +65,10 @@ func (s *Server) setFuncComment(ctx context.Context, req *mcp.CallToolRequest, a
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.Comment)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -89,6 +99,10 @@ func (s *Server) setDecompilerComment(ctx context.Context, req *mcp.CallToolRequ
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, nil, args.Comment)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -105,6 +119,7 @@ func (s *Server) setName(ctx context.Context, req *mcp.CallToolRequest, args Set
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readName(ctx, client, args.Address)
 	resp, err := (*client.Analysis).SetName(ctx, connect.NewRequest(&pb.SetNameRequest{
 		Address: args.Address,
 		Name:    args.Name,
@@ -115,6 +130,10 @@ func (s *Server) setName(ctx context.Context, req *mcp.CallToolRequest, args Set
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.Name)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -131,6 +150,7 @@ func (s *Server) deleteName(ctx context.Context, req *mcp.CallToolRequest, args
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readName(ctx, client, args.Address)
 	resp, err := (*client.Analysis).DeleteName(ctx, connect.NewRequest(&pb.DeleteNameRequest{
 		Address: args.Address,
 	}))
@@ -140,6 +160,10 @@ func (s *Server) deleteName(ctx context.Context, req *mcp.CallToolRequest, args
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, "")
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -173,6 +197,10 @@ func (s *Server) setLvarType(ctx context.Context, req *mcp.CallToolRequest, args
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.FunctionAddress, args, nil, args.LvarType)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -206,6 +234,10 @@ func (s *Server) renameLvar(ctx context.Context, req *mcp.CallToolRequest, args
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.FunctionAddress, args, &args.LvarName, args.NewName)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -225,6 +257,7 @@ func (s *Server) setGlobalType(ctx context.Context, req *mcp.CallToolRequest, ar
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readType(ctx, client, args.Address)
 	resp, err := (*client.Analysis).SetGlobalType(ctx, connect.NewRequest(&pb.SetGlobalTypeRequest{Address: args.Address, Type: args.Type}))
 	if err != nil {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, err))
@@ -232,6 +265,10 @@ func (s *Server) setGlobalType(ctx context.Context, req *mcp.CallToolRequest, ar
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.Type)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -251,6 +288,7 @@ func (s *Server) renameGlobal(ctx context.Context, req *mcp.CallToolRequest, arg
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readName(ctx, client, args.Address)
 	resp, err := (*client.Analysis).RenameGlobal(ctx, connect.NewRequest(&pb.RenameGlobalRequest{Address: args.Address, NewName: args.NewName}))
 	if err != nil {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, err))
@@ -258,6 +296,10 @@ func (s *Server) renameGlobal(ctx context.Context, req *mcp.CallToolRequest, arg
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.NewName)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -277,6 +319,7 @@ func (s *Server) setFunctionType(ctx context.Context, req *mcp.CallToolRequest,
 	if err != nil {
 		return s.handleToolError(workerUnavailable(op, sess.ID, err))
 	}
+	prior := readType(ctx, client, args.Address)
 	resp, err := (*client.Analysis).SetFunctionType(ctx, connect.NewRequest(&pb.SetFunctionTypeRequest{
 		Address:   args.Address,
 		Prototype: args.Prototype,
@@ -287,6 +330,10 @@ func (s *Server) setFunctionType(ctx context.Context, req *mcp.CallToolRequest,
 	if msgErr := resp.Msg.GetError(); msgErr != "" {
 		return s.handleToolError(idaOperationFailed(op, sess.ID, errors.New(msgErr)))
 	}
+	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, prior, args.Prototype)
+	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
 	return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(result)}}}, nil, nil
 }
@@ -314,6 +361,8 @@ func (s *Server) makeFunction(ctx context.Context, req *mcp.CallToolRequest, arg
 	}
 
 	if resp.Msg.GetSuccess() {
+		sess.MarkDirty()
+		s.recordChange(ctx, req, sess, op, args.Address, args, nil, "")
 		s.deleteSessionCache(sess.ID)
 	}
 	result, _ := s.marshalJSON(map[string]any{"success": resp.Msg.GetSuccess()})
@@ -0,0 +1,59 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"time"
+)
+
+// FileIdentity records which bytes a session's database was built from.
+type FileIdentity struct {
+	SHA256  string
+	Size    int64
+	ModTime time.Time
+}
+
+// IdentifyFile hashes the file at path.
+func IdentifyFile(path string) (FileIdentity, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return FileIdentity{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return FileIdentity{}, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return FileIdentity{}, err
+	}
+	return FileIdentity{
+		SHA256:  hex.EncodeToString(h.Sum(nil)),
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}, nil
+}
+
+// Known reports whether the identity was recorded.
+func (f FileIdentity) Known() bool {
+	return f.SHA256 != ""
+}
+
+// Differs reports whether both identities are known and name different
+// contents.
+func (f FileIdentity) Differs(other FileIdentity) bool {
+	return f.Known() && other.Known() && f.SHA256 != other.SHA256
+}
+
+// Unchanged reports whether the file at path still has the recorded size and
+// modification time. It is a cheap check before rehashing.
+func (f FileIdentity) Unchanged(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return info.Size() == f.Size && info.ModTime().Equal(f.ModTime)
+}
@@ -0,0 +1,76 @@
+package session
+
+import (
+	"maps"
+	"path/filepath"
+	"strings"
+)
+
+// Labels are client-chosen names for a session, so agents sharing a server
+// can tell their sessions apart.
+type Labels struct {
+	Label string
+	Owner string
+	Tags  map[string]string
+}
+
+// Filter selects sessions by their labels and binary path. Zero fields match
+// every session.
+type Filter struct {
+	Owner string
+	// Tags must all be present; an empty value matches any value
+	Tags map[string]string
+	// PathGlob is matched against the binary path, or against its base name
+	// when the pattern has no separator
+	PathGlob string
+}
+
+// Validate reports a malformed path glob.
+func (f Filter) Validate() error {
+	if f.PathGlob == "" {
+		return nil
+	}
+	_, err := filepath.Match(f.PathGlob, "")
+	return err
+}
+
+// Matches reports whether the session passes the filter.
+func (f Filter) Matches(sess *Session) bool {
+	labels := sess.Labels()
+	if f.Owner != "" && labels.Owner != f.Owner {
+		return false
+	}
+	for key, want := range f.Tags {
+		got, ok := labels.Tags[key]
+		if !ok || (want != "" && got != want) {
+			return false
+		}
+	}
+	if f.PathGlob != "" {
+		target := sess.BinaryPath
+		if !strings.ContainsRune(f.PathGlob, filepath.Separator) {
+			target = filepath.Base(target)
+		}
+		if ok, _ := filepath.Match(f.PathGlob, target); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Labels returns the session's labels.
+func (s *Session) Labels() Labels {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	labels := s.labels
+	labels.Tags = maps.Clone(s.labels.Tags)
+	return labels
+}
+
+// SetLabels replaces the session's labels.
+func (s *Session) SetLabels(labels Labels) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	labels.Tags = maps.Clone(labels.Tags)
+	s.labels = labels
+}
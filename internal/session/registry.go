@@ -1,8 +1,13 @@
 package session
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
@@ -18,10 +23,64 @@ type Session struct {
 	Timeout      time.Duration
 	SocketPath   string
 	WorkerPID    int
+	// Identity is the binary the database was built from, if it was readable
+	Identity FileIdentity
+	// ParentID is set on sessions forked from another session
+	ParentID string
+	// DatabasePath is where the server placed the IDA database; empty means
+	// idalib keeps it next to the binary
+	DatabasePath string
+
+	changes      uint64
+	savedChanges uint64
+	lastSave     time.Time
+	saveFailures int
+	lastSaveErr  string
+	snapshots    []Snapshot
+	labels       Labels
+	info         DatabaseInfo
+
+	// Busy sessions are never reaped: inFlight counts running tool calls and
+	// pinned is set explicitly by the client
+	inFlight int
+	pinned   bool
+	// dormant sessions were restored from disk or evicted and have no
+	// worker until Registry.Wake starts one
+	dormant bool
 
 	mu sync.RWMutex
 }
 
+// SaveStatus describes the persistence state of a session's database.
+type SaveStatus struct {
+	Dirty        bool
+	LastSave     time.Time
+	SaveFailures int
+	LastError    string
+}
+
+// DatabaseInfo is what the worker reports about an opened database.
+type DatabaseInfo struct {
+	HasDecompiler bool
+	Processor     string
+	Bitness       int
+}
+
+// DatabaseInfo returns what the worker last reported about the database.
+func (s *Session) DatabaseInfo() DatabaseInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.info
+}
+
+// SetDatabaseInfo records what the worker reported when it opened the
+// database.
+func (s *Session) SetDatabaseInfo(info DatabaseInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.info = info
+}
+
 // Touch updates last activity timestamp
 func (s *Session) Touch() {
 	s.mu.Lock()
@@ -29,39 +88,255 @@ func (s *Session) Touch() {
 	s.LastActivity = time.Now()
 }
 
-// IsExpired checks if session exceeded timeout
+// IsExpired checks if session exceeded timeout. Sessions with calls in
+// flight or pinned sessions never expire.
 func (s *Session) IsExpired() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	if s.inFlight > 0 || s.pinned {
+		return false
+	}
 	return time.Since(s.LastActivity) > s.Timeout
 }
 
+// Begin records the start of a tool call and returns the function that
+// records its end. The idle timeout restarts when the call finishes.
+func (s *Session) Begin() (end func()) {
+	s.mu.Lock()
+	s.inFlight++
+	s.LastActivity = time.Now()
+	s.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			s.inFlight--
+			s.LastActivity = time.Now()
+			s.mu.Unlock()
+		})
+	}
+}
+
+// InFlight returns the number of tool calls currently running.
+func (s *Session) InFlight() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.inFlight
+}
+
+// SetPinned exempts the session from idle reaping, or lifts the exemption.
+func (s *Session) SetPinned(pinned bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pinned = pinned
+	s.LastActivity = time.Now()
+}
+
+// Dormant reports whether the session is waiting for its first use to start
+// a worker.
+func (s *Session) Dormant() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.dormant
+}
+
+func (s *Session) setDormant(dormant bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dormant = dormant
+}
+
+// suspend marks an idle, unpinned session dormant. It fails if the session
+// has a call in flight, so a call never runs against an evicted worker.
+func (s *Session) suspend() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight > 0 || s.pinned || s.dormant {
+		return false
+	}
+	s.dormant = true
+	return true
+}
+
+func (s *Session) lastActivity() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.LastActivity
+}
+
+// Pinned reports whether the session is exempt from idle reaping and eviction.
+func (s *Session) Pinned() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.pinned
+}
+
+// MarkDirty records that the database has unsaved changes.
+func (s *Session) MarkDirty() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.changes++
+}
+
+// IsDirty reports whether changes were made since the last successful save.
+func (s *Session) IsDirty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.changes != s.savedChanges
+}
+
+// Changes returns the change generation, to be passed to RecordSave once a
+// save started at this point completes.
+func (s *Session) Changes() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.changes
+}
+
+// RecordSave marks every change up to generation as saved.
+// Changes made while the save was in flight keep the session dirty.
+func (s *Session) RecordSave(generation uint64, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation > s.savedChanges {
+		s.savedChanges = generation
+	}
+	s.lastSave = at
+	s.lastSaveErr = ""
+}
+
+// RecordSaveFailure counts a failed save attempt.
+func (s *Session) RecordSaveFailure(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saveFailures++
+	s.lastSaveErr = err.Error()
+}
+
+// SaveStatus returns a snapshot of the session's save state.
+func (s *Session) SaveStatus() SaveStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return SaveStatus{
+		Dirty:        s.changes != s.savedChanges,
+		LastSave:     s.lastSave,
+		SaveFailures: s.saveFailures,
+		LastError:    s.lastSaveErr,
+	}
+}
+
 // Metadata returns the persisted metadata for this session.
 func (s *Session) Metadata() Metadata {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	return Metadata{
-		ID:           s.ID,
-		BinaryPath:   s.BinaryPath,
-		CreatedAt:    s.CreatedAt,
-		LastActivity: s.LastActivity,
-		Timeout:      s.Timeout,
+		ID:            s.ID,
+		BinaryPath:    s.BinaryPath,
+		CreatedAt:     s.CreatedAt,
+		LastActivity:  s.LastActivity,
+		Timeout:       s.Timeout,
+		Pinned:        s.pinned,
+		LastSave:      s.lastSave,
+		SHA256:        s.Identity.SHA256,
+		Size:          s.Identity.Size,
+		ModTime:       s.Identity.ModTime,
+		Snapshots:     slices.Clone(s.snapshots),
+		ParentID:      s.ParentID,
+		Label:         s.labels.Label,
+		Owner:         s.labels.Owner,
+		Tags:          maps.Clone(s.labels.Tags),
+		DatabasePath:  s.DatabasePath,
+		HasDecompiler: s.info.HasDecompiler,
+		Processor:     s.info.Processor,
+		Bitness:       s.info.Bitness,
+	}
+}
+
+// AddSnapshot records a database snapshot, numbering it after the last one,
+// and returns it.
+func (s *Session) AddSnapshot(snap Snapshot) Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap.ID = 1
+	if n := len(s.snapshots); n > 0 {
+		snap.ID = s.snapshots[n-1].ID + 1
+	}
+	s.snapshots = append(s.snapshots, snap)
+	return snap
+}
+
+// UpdateSnapshot replaces the snapshot with the same ID.
+func (s *Session) UpdateSnapshot(snap Snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.snapshots {
+		if s.snapshots[i].ID == snap.ID {
+			s.snapshots[i] = snap
+		}
 	}
 }
 
+// DeleteSnapshot forgets the snapshot with the given ID.
+func (s *Session) DeleteSnapshot(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots = slices.DeleteFunc(s.snapshots, func(snap Snapshot) bool { return snap.ID == id })
+}
+
+// Snapshots returns the session's snapshots, oldest first.
+func (s *Session) Snapshots() []Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.snapshots)
+}
+
+// Snapshot returns the snapshot with the given ID.
+func (s *Session) Snapshot(id int) (Snapshot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, snap := range s.snapshots {
+		if snap.ID == id {
+			return snap, true
+		}
+	}
+	return Snapshot{}, false
+}
+
+var (
+	// ErrSessionClosed is returned to callers waiting on an open that was
+	// abandoned because its session was deleted.
+	ErrSessionClosed = errors.New("session closed while opening")
+	// ErrMaxSessions is returned when the session limit is reached.
+	ErrMaxSessions = errors.New("max sessions reached")
+	// ErrNoIdleSession is returned by Evict when every active session is
+	// busy, pinned or still opening.
+	ErrNoIdleSession = errors.New("no idle session to evict")
+)
+
 // Registry manages active sessions
 type Registry struct {
 	sessions    map[string]*Session
 	binaryIndex map[string]*Session
+	hashIndex   map[string]*Session     // by SHA-256 of the binary
+	opening     map[string]*pendingOpen // by session ID
 	mu          sync.RWMutex
 	maxSessions int
 }
 
+// pendingOpen lets concurrent opens of the same binary wait for the first.
+type pendingOpen struct {
+	done chan struct{}
+	err  error
+}
+
 // NewRegistry creates session registry
 func NewRegistry(maxSessions int) *Registry {
 	return &Registry{
 		sessions:    make(map[string]*Session),
 		binaryIndex: make(map[string]*Session),
+		hashIndex:   make(map[string]*Session),
+		opening:     make(map[string]*pendingOpen),
 		maxSessions: maxSessions,
 	}
 }
@@ -70,9 +345,271 @@ func NewRegistry(maxSessions int) *Registry {
 func (r *Registry) Create(binaryPath string, timeout time.Duration) (*Session, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.createLocked(binaryPath, FileIdentity{}, timeout)
+}
+
+// OpenOrJoin atomically finds or creates the session for binaryPath. A
+// binary with no session at its path joins the session of an identical
+// binary elsewhere, by SHA-256.
+//
+// When it creates the session it returns created=true, and the caller must
+// call FinishOpen once the worker is ready or has failed. A caller arriving
+// while that open is in progress waits for it and gets the same session, or
+// the error the open failed with. A created session keeps its database at
+// databasePath, or next to the binary when it is empty.
+func (r *Registry) OpenOrJoin(ctx context.Context, binaryPath, databasePath string, identity FileIdentity, timeout time.Duration) (*Session, bool, error) {
+	r.mu.Lock()
+	sess, ok := r.binaryIndex[filepath.Clean(binaryPath)]
+	if !ok && identity.Known() {
+		sess, ok = r.hashIndex[identity.SHA256]
+	}
+	if ok {
+		pending := r.opening[sess.ID]
+		r.mu.Unlock()
+		if pending == nil {
+			return sess, false, nil
+		}
+		select {
+		case <-pending.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if pending.err != nil {
+			return nil, false, pending.err
+		}
+		return sess, false, nil
+	}
+	defer r.mu.Unlock()
+
+	sess, err := r.createLocked(binaryPath, identity, timeout)
+	if err != nil {
+		return nil, false, err
+	}
+	sess.DatabasePath = databasePath
+	r.opening[sess.ID] = &pendingOpen{done: make(chan struct{})}
+	return sess, true, nil
+}
+
+// OpenFork creates a session forked from parent, working on the copy of its
+// database at databasePath. Like OpenOrJoin, the caller must call
+// FinishOpen once the fork's worker is ready or has failed.
+func (r *Registry) OpenFork(parent *Session, binaryPath, databasePath string, timeout time.Duration) (*Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sess, err := r.openNewLocked(binaryPath, FileIdentity{}, timeout)
+	if err != nil {
+		return nil, err
+	}
+	sess.DatabasePath = databasePath
+	sess.ParentID = parent.ID
+	sess.Identity = parent.Identity
+	return sess, nil
+}
+
+// OpenNew creates a session for a database the server placed at
+// binaryPath itself, never joining an existing session. Like OpenOrJoin, the
+// caller must call FinishOpen once the worker is ready or has failed.
+func (r *Registry) OpenNew(binaryPath string, identity FileIdentity, timeout time.Duration) (*Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.openNewLocked(binaryPath, identity, timeout)
+}
+
+func (r *Registry) openNewLocked(binaryPath string, identity FileIdentity, timeout time.Duration) (*Session, error) {
+	if r.binaryIndex[filepath.Clean(binaryPath)] != nil {
+		return nil, fmt.Errorf("a session already uses %s", binaryPath)
+	}
+	sess, err := r.createLocked(binaryPath, identity, timeout)
+	if err != nil {
+		return nil, err
+	}
+	r.opening[sess.ID] = &pendingOpen{done: make(chan struct{})}
+	return sess, nil
+}
+
+// FinishOpen completes an open started by OpenOrJoin, OpenFork or OpenNew
+// and wakes its waiters. A non-nil err removes the session and is returned
+// to every waiter.
+func (r *Registry) FinishOpen(id string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending, ok := r.opening[id]
+	if !ok {
+		return
+	}
+	delete(r.opening, id)
+	if err != nil {
+		r.deleteLocked(id)
+	}
+	pending.err = err
+	close(pending.done)
+}
+
+// Wake starts the worker of a dormant session by calling start, once.
+// Concurrent callers wait for that start and share its result. A failed start
+// leaves the session dormant so a later call can retry. Active sessions
+// return immediately.
+func (r *Registry) Wake(ctx context.Context, id string, start func(*Session) error) error {
+	r.mu.Lock()
+	sess, ok := r.sessions[id]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("session %s not found", id)
+	}
+	if pending := r.opening[id]; pending != nil {
+		r.mu.Unlock()
+		select {
+		case <-pending.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if pending.err != nil {
+			return pending.err
+		}
+		// The session may have just been evicted; check again
+		return r.Wake(ctx, id, start)
+	}
+	if !sess.Dormant() {
+		r.mu.Unlock()
+		return nil
+	}
+	if err := r.checkCapacityLocked(); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	// Counted as active from here so concurrent wakes respect the limit
+	sess.setDormant(false)
+	pending := &pendingOpen{done: make(chan struct{})}
+	r.opening[id] = pending
+	r.mu.Unlock()
+
+	err := start(sess)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		sess.setDormant(true)
+	}
+	// Delete may have closed the session meanwhile and released its waiters
+	if r.opening[id] == pending {
+		delete(r.opening, id)
+		pending.err = err
+		close(pending.done)
+	}
+	return err
+}
 
-	if r.maxSessions > 0 && len(r.sessions) >= r.maxSessions {
-		return nil, fmt.Errorf("max sessions (%d) reached", r.maxSessions)
+// Evict frees a slot under the session limit by putting the least recently
+// active idle session to sleep. Sessions with calls in flight, pinned
+// sessions and sessions still opening are never chosen. suspend saves and
+// stops the chosen session's worker; if it fails, the session stays active
+// and the next candidate is tried. Calls arriving meanwhile wait for the
+// eviction and then wake the session again.
+func (r *Registry) Evict(suspend func(*Session) error) (*Session, error) {
+	lastErr := ErrNoIdleSession
+	tried := make(map[string]bool)
+	for {
+		r.mu.Lock()
+		sess := r.suspendLRULocked(tried)
+		if sess == nil {
+			r.mu.Unlock()
+			return nil, lastErr
+		}
+		tried[sess.ID] = true
+		err := r.whileSuspendedLocked(sess, func() error { return suspend(sess) }, true)
+		if err == nil {
+			return sess, nil
+		}
+		lastErr = fmt.Errorf("evict session %s: %w", sess.ID, err)
+	}
+}
+
+// Suspend makes a session dormant and runs stop while calls arriving for it
+// wait, as Evict does, but for a chosen session even if it is busy or
+// pinned. stop is told whether the session had a worker. The session stays
+// dormant even if stop fails; its next use starts a worker again.
+func (r *Registry) Suspend(id string, stop func(sess *Session, active bool) error) error {
+	r.mu.Lock()
+	sess, ok := r.sessions[id]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("session %s not found", id)
+	}
+	if r.opening[id] != nil {
+		r.mu.Unlock()
+		return fmt.Errorf("session %s is opening", id)
+	}
+	active := !sess.Dormant()
+	sess.setDormant(true)
+	return r.whileSuspendedLocked(sess, func() error { return stop(sess, active) }, false)
+}
+
+// whileSuspendedLocked runs fn for a session just marked dormant. Callers
+// waiting on the session block until fn returns, and the slot stays counted
+// until then. A failed fn makes the session active again if revert is set.
+// r.mu must be held and is released.
+func (r *Registry) whileSuspendedLocked(sess *Session, fn func() error, revert bool) error {
+	pending := &pendingOpen{done: make(chan struct{})}
+	r.opening[sess.ID] = pending
+	r.mu.Unlock()
+
+	err := fn()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil && revert {
+		sess.setDormant(false)
+	}
+	// Delete may have closed the session meanwhile and released its waiters
+	if r.opening[sess.ID] == pending {
+		delete(r.opening, sess.ID)
+		close(pending.done)
+	}
+	return err
+}
+
+// suspendLRULocked marks the least recently active idle session dormant and
+// returns it, or nil if there is none.
+func (r *Registry) suspendLRULocked(skip map[string]bool) *Session {
+	candidates := make([]*Session, 0, len(r.sessions))
+	for id, sess := range r.sessions {
+		if skip[id] || r.opening[id] != nil || sess.Dormant() {
+			continue
+		}
+		candidates = append(candidates, sess)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastActivity().Before(candidates[j].lastActivity())
+	})
+	for _, sess := range candidates {
+		if sess.suspend() {
+			return sess
+		}
+	}
+	return nil
+}
+
+// checkCapacityLocked enforces maxSessions. Dormant sessions do not count,
+// unless they are still being evicted.
+func (r *Registry) checkCapacityLocked() error {
+	if r.maxSessions <= 0 {
+		return nil
+	}
+	active := 0
+	for id, sess := range r.sessions {
+		if !sess.Dormant() || r.opening[id] != nil {
+			active++
+		}
+	}
+	if active >= r.maxSessions {
+		return fmt.Errorf("%w (limit %d)", ErrMaxSessions, r.maxSessions)
+	}
+	return nil
+}
+
+func (r *Registry) createLocked(binaryPath string, identity FileIdentity, timeout time.Duration) (*Session, error) {
+	if err := r.checkCapacityLocked(); err != nil {
+		return nil, err
 	}
 
 	normPath := filepath.Clean(binaryPath)
@@ -83,21 +620,31 @@ func (r *Registry) Create(binaryPath string, timeout time.Duration) (*Session, e
 		CreatedAt:    time.Now(),
 		LastActivity: time.Now(),
 		Timeout:      timeout,
+		Identity:     identity,
 	}
 
-	r.sessions[session.ID] = session
-	r.binaryIndex[normPath] = session
+	r.indexLocked(session)
 	return session, nil
 }
 
-// Restore inserts an existing session into the registry (used on server restart).
+func (r *Registry) indexLocked(sess *Session) {
+	r.sessions[sess.ID] = sess
+	r.binaryIndex[sess.BinaryPath] = sess
+	// Forks hold the same bytes as their parent but are never joined by hash
+	if sess.Identity.Known() && sess.ParentID == "" {
+		if _, taken := r.hashIndex[sess.Identity.SHA256]; !taken {
+			r.hashIndex[sess.Identity.SHA256] = sess
+		}
+	}
+}
+
+// Restore inserts an existing session into the registry in the dormant state
+// (used on server restart). Dormant sessions do not count toward the session
+// limit until they are woken.
 func (r *Registry) Restore(meta Metadata) (*Session, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if r.maxSessions > 0 && len(r.sessions) >= r.maxSessions {
-		return nil, fmt.Errorf("max sessions (%d) reached", r.maxSessions)
-	}
 	if _, exists := r.sessions[meta.ID]; exists {
 		return nil, fmt.Errorf("session %s already exists", meta.ID)
 	}
@@ -109,9 +656,29 @@ func (r *Registry) Restore(meta Metadata) (*Session, error) {
 		CreatedAt:    meta.CreatedAt,
 		LastActivity: meta.LastActivity,
 		Timeout:      meta.Timeout,
+		Identity: FileIdentity{
+			SHA256:  meta.SHA256,
+			Size:    meta.Size,
+			ModTime: meta.ModTime,
+		},
+		ParentID:     meta.ParentID,
+		DatabasePath: meta.DatabasePath,
+		snapshots:    meta.Snapshots,
+		lastSave:     meta.LastSave,
+		info: DatabaseInfo{
+			HasDecompiler: meta.HasDecompiler,
+			Processor:     meta.Processor,
+			Bitness:       meta.Bitness,
+		},
+		labels: Labels{
+			Label: meta.Label,
+			Owner: meta.Owner,
+			Tags:  meta.Tags,
+		},
+		pinned:  meta.Pinned,
+		dormant: true,
 	}
-	r.sessions[session.ID] = session
-	r.binaryIndex[normPath] = session
+	r.indexLocked(session)
 	return session, nil
 }
 
@@ -123,6 +690,15 @@ func (r *Registry) FindByBinaryPath(path string) (*Session, bool) {
 	return sess, ok
 }
 
+// FindBySHA256 returns the session whose database was built from a binary
+// with the given SHA-256, wherever that binary lives.
+func (r *Registry) FindBySHA256(hash string) (*Session, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sess, ok := r.hashIndex[hash]
+	return sess, ok
+}
+
 // Get retrieves session by ID
 func (r *Registry) Get(id string) (*Session, bool) {
 	r.mu.RLock()
@@ -131,12 +707,26 @@ func (r *Registry) Get(id string) (*Session, bool) {
 	return session, ok
 }
 
-// Delete removes session
+// Delete removes session. Callers waiting on its open get ErrSessionClosed.
 func (r *Registry) Delete(id string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	if pending, ok := r.opening[id]; ok {
+		delete(r.opening, id)
+		pending.err = ErrSessionClosed
+		close(pending.done)
+	}
+	r.deleteLocked(id)
+}
+
+func (r *Registry) deleteLocked(id string) {
 	if sess, ok := r.sessions[id]; ok {
-		delete(r.binaryIndex, sess.BinaryPath)
+		if r.binaryIndex[sess.BinaryPath] == sess {
+			delete(r.binaryIndex, sess.BinaryPath)
+		}
+		if r.hashIndex[sess.Identity.SHA256] == sess {
+			delete(r.hashIndex, sess.Identity.SHA256)
+		}
 		delete(r.sessions, id)
 	}
 }
@@ -2,9 +2,8 @@ package session
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
 	"sync"
 	"time"
 )
@@ -17,75 +16,228 @@ type Metadata struct {
 	LastActivity  time.Time     `json:"last_activity"`
 	Timeout       time.Duration `json:"timeout"`
 	HasDecompiler bool          `json:"has_decompiler"`
+	Pinned        bool          `json:"pinned,omitempty"`
+	// What the worker reported about the database when it was opened
+	Processor string `json:"processor,omitempty"`
+	Bitness   int    `json:"bitness,omitempty"`
+	// When the database was last saved
+	LastSave time.Time `json:"last_save,omitempty"`
+	// Identity of the binary the database was built from
+	SHA256  string    `json:"sha256,omitempty"`
+	Size    int64     `json:"size,omitempty"`
+	ModTime time.Time `json:"mod_time,omitempty"`
+	// Database copies made by snapshot_database, oldest first
+	Snapshots []Snapshot `json:"snapshots,omitempty"`
+	// Session this one was forked from
+	ParentID string `json:"parent_id,omitempty"`
+	// Where the server placed the database, if not next to the binary
+	DatabasePath string `json:"database_path,omitempty"`
+	// Client-chosen labels
+	Label string            `json:"label,omitempty"`
+	Owner string            `json:"owner,omitempty"`
+	Tags  map[string]string `json:"tags,omitempty"`
 }
 
+// Snapshot is a saved copy of a session's database.
+type Snapshot struct {
+	ID        int       `json:"id"`
+	Label     string    `json:"label,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Size      int64     `json:"size"`
+	Path      string    `json:"path"`
+}
+
+// StoreVersion is the record format Save writes. Version 1 records are the
+// bare metadata written before records were versioned.
+const StoreVersion = 2
+
+// migrations[v] upgrades a record's session body from format version v to
+// v+1. Load applies them in order and rewrites the upgraded record.
+var migrations = map[int]func(json.RawMessage) (json.RawMessage, error){
+	// Version 2 only wraps the body in the versioned envelope
+	1: func(body json.RawMessage) (json.RawMessage, error) { return body, nil },
+}
+
+// record is the envelope every stored session is written in.
+type record struct {
+	Version int             `json:"version"`
+	Session json.RawMessage `json:"session"`
+}
+
+// errNewerRecord marks a record written by a newer server. It is left in
+// place rather than quarantined, so a downgrade does not lose it.
+var errNewerRecord = errors.New("record written by a newer server")
+
+func encodeRecord(meta Metadata) ([]byte, error) {
+	body, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(record{Version: StoreVersion, Session: body}, "", "  ")
+}
+
+// decodeRecord decodes the record stored under id, migrating it to
+// StoreVersion. migrated is set when the stored record is older.
+func decodeRecord(id string, data []byte) (meta Metadata, migrated bool, err error) {
+	var rec record
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return meta, false, err
+	}
+	version, body := rec.Version, rec.Session
+	if version == 0 {
+		version, body = 1, data
+	}
+	if version > StoreVersion {
+		return meta, false, fmt.Errorf("%w (version %d, this server reads up to %d)", errNewerRecord, version, StoreVersion)
+	}
+	if len(body) == 0 {
+		return meta, false, errors.New("record has no session")
+	}
+	for ; version < StoreVersion; version++ {
+		migrate, ok := migrations[version]
+		if !ok {
+			return meta, false, fmt.Errorf("no migration from version %d", version)
+		}
+		if body, err = migrate(body); err != nil {
+			return meta, false, fmt.Errorf("migrate from version %d: %w", version, err)
+		}
+		migrated = true
+	}
+	if err := json.Unmarshal(body, &meta); err != nil {
+		return meta, false, err
+	}
+	switch {
+	case meta.ID != id:
+		return meta, false, fmt.Errorf("record holds session %q", meta.ID)
+	case meta.BinaryPath == "":
+		return meta, false, errors.New("record has no binary path")
+	}
+	return meta, migrated, nil
+}
+
+// Backend holds encoded session records by session ID.
+type Backend interface {
+	List() ([]string, error)
+	Get(id string) ([]byte, error)
+	Put(id string, data []byte) error
+	// Delete succeeds if the record does not exist
+	Delete(id string) error
+	// Quarantine moves the record out of the way, keeping it for inspection
+	Quarantine(id string) error
+	Close() error
+}
+
+// RecordError describes a stored record Load could not restore.
+type RecordError struct {
+	ID string
+	// Quarantined is set when the record was unreadable and moved aside
+	Quarantined bool
+	Err         error
+}
+
+func (e *RecordError) Error() string {
+	if e.Quarantined {
+		return fmt.Sprintf("session %s: %v (quarantined)", e.ID, e.Err)
+	}
+	return fmt.Sprintf("session %s: %v", e.ID, e.Err)
+}
+
+func (e *RecordError) Unwrap() error { return e.Err }
+
 // Store persists session metadata so the server can recover after restarts.
 type Store struct {
-	dir string
-	mu  sync.Mutex
+	backend Backend
+	mu      sync.Mutex
 }
 
-// NewStore creates a session store under the provided directory.
+// NewStore creates a session store keeping one JSON file per session under
+// the provided directory.
 func NewStore(dir string) (*Store, error) {
-	if err := os.MkdirAll(dir, 0o755); err != nil {
-		return nil, fmt.Errorf("create session store dir: %w", err)
+	backend, err := newFileBackend(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{backend: backend}, nil
+}
+
+// OpenBoltStore creates a session store keeping every session in the
+// embedded key-value database at path.
+func OpenBoltStore(path string) (*Store, error) {
+	backend, err := openBoltBackend(path)
+	if err != nil {
+		return nil, err
 	}
-	return &Store{dir: dir}, nil
+	return &Store{backend: backend}, nil
 }
 
-// Save writes the session metadata to disk.
+// Save writes the session metadata.
 func (s *Store) Save(sess *Session) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	data, err := json.MarshalIndent(sess.Metadata(), "", "  ")
+	data, err := encodeRecord(sess.Metadata())
 	if err != nil {
 		return err
 	}
-	tmp := filepath.Join(s.dir, sess.ID+".json.tmp")
-	target := filepath.Join(s.dir, sess.ID+".json")
-	if err := os.WriteFile(tmp, data, 0o644); err != nil {
-		return err
-	}
-	return os.Rename(tmp, target)
+	return s.backend.Put(sess.ID, data)
 }
 
-// Delete removes the session metadata file.
+// Delete removes the session metadata.
 func (s *Store) Delete(sessionID string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	path := filepath.Join(s.dir, sessionID+".json")
-	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
-		return err
-	}
-	return nil
+	return s.backend.Delete(sessionID)
 }
 
-// Load returns all sessions saved on disk.
-func (s *Store) Load() ([]Metadata, error) {
+// Load returns all sessions in the store, upgrading records written in an
+// older format. Records that cannot be decoded are quarantined and reported
+// with the ones that cannot be read, without failing the load; the error is
+// only set when the store itself cannot be read.
+func (s *Store) Load() ([]Metadata, []*RecordError, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	entries, err := os.ReadDir(s.dir)
+	ids, err := s.backend.List()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var metas []Metadata
-	for _, entry := range entries {
-		if entry.IsDir() {
+	var problems []*RecordError
+	for _, id := range ids {
+		data, err := s.backend.Get(id)
+		if err != nil {
+			problems = append(problems, &RecordError{ID: id, Err: err})
+			continue
+		}
+		meta, migrated, err := decodeRecord(id, data)
+		if errors.Is(err, errNewerRecord) {
+			problems = append(problems, &RecordError{ID: id, Err: err})
 			continue
 		}
-		path := filepath.Join(s.dir, entry.Name())
-		data, err := os.ReadFile(path)
 		if err != nil {
-			return nil, err
+			if qErr := s.backend.Quarantine(id); qErr != nil {
+				problems = append(problems, &RecordError{ID: id, Err: errors.Join(err, fmt.Errorf("quarantine: %w", qErr))})
+				continue
+			}
+			problems = append(problems, &RecordError{ID: id, Quarantined: true, Err: err})
+			continue
 		}
-		var meta Metadata
-		if err := json.Unmarshal(data, &meta); err != nil {
-			return nil, fmt.Errorf("decode %s: %w", path, err)
+		if migrated {
+			// Best effort: a record that cannot be rewritten migrates again
+			// on the next load
+			if data, err := encodeRecord(meta); err == nil {
+				s.backend.Put(id, data)
+			}
 		}
 		metas = append(metas, meta)
 	}
-	return metas, nil
+	return metas, problems, nil
+}
+
+// Close releases the store's backend.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.backend.Close()
 }
@@ -0,0 +1,101 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	sessionsBucket   = []byte("sessions")
+	quarantineBucket = []byte("quarantine")
+)
+
+// boltBackend keeps records in a bbolt database, one key per session.
+// Quarantined records move to a separate bucket.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+func openBoltBackend(path string) (*boltBackend, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create session store dir: %w", err)
+	}
+	// Another server holding the file fails the open instead of blocking it
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open session store %s: %w", path, err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{sessionsBucket, quarantineBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initialize session store %s: %w", path, err)
+	}
+	return &boltBackend{db: db}, nil
+}
+
+func (b *boltBackend) List() ([]string, error) {
+	var ids []string
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).ForEach(func(k, _ []byte) error {
+			ids = append(ids, string(k))
+			return nil
+		})
+	})
+	return ids, err
+}
+
+func (b *boltBackend) Get(id string) ([]byte, error) {
+	var data []byte
+	err := b.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(sessionsBucket).Get([]byte(id))
+		if value == nil {
+			return fmt.Errorf("no record for session %s", id)
+		}
+		// Values are only valid inside the transaction
+		data = append([]byte(nil), value...)
+		return nil
+	})
+	return data, err
+}
+
+func (b *boltBackend) Put(id string, data []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Put([]byte(id), data)
+	})
+}
+
+func (b *boltBackend) Delete(id string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Delete([]byte(id))
+	})
+}
+
+func (b *boltBackend) Quarantine(id string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(sessionsBucket)
+		value := sessions.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+		key := fmt.Sprintf("%s.%d", id, time.Now().UnixNano())
+		if err := tx.Bucket(quarantineBucket).Put([]byte(key), append([]byte(nil), value...)); err != nil {
+			return err
+		}
+		return sessions.Delete([]byte(id))
+	})
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
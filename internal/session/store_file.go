@@ -0,0 +1,110 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	recordExt     = ".json"
+	quarantineDir = "quarantine"
+)
+
+// fileBackend keeps each record in <dir>/<id>.json. Quarantined records move
+// to <dir>/quarantine.
+type fileBackend struct {
+	dir string
+}
+
+func newFileBackend(dir string) (*fileBackend, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create session store dir: %w", err)
+	}
+	return &fileBackend{dir: dir}, nil
+}
+
+func (b *fileBackend) path(id string) string {
+	return filepath.Join(b.dir, id+recordExt)
+}
+
+// List skips anything that is not a record, such as the .json.tmp left by a
+// write that was interrupted.
+func (b *fileBackend) List() ([]string, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && strings.HasSuffix(name, recordExt) {
+			ids = append(ids, strings.TrimSuffix(name, recordExt))
+		}
+	}
+	return ids, nil
+}
+
+func (b *fileBackend) Get(id string) ([]byte, error) {
+	return os.ReadFile(b.path(id))
+}
+
+// Put writes the record to a temporary file and renames it into place, so a
+// crash leaves either the old record or the new one. Both the file and the
+// rename are synced before Put returns.
+func (b *fileBackend) Put(id string, data []byte) error {
+	tmp := b.path(id) + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, b.path(id)); err != nil {
+		return err
+	}
+	return syncDir(b.dir)
+}
+
+// syncDir flushes a rename in dir to disk. Windows cannot sync a directory
+// and journals renames itself.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (b *fileBackend) Delete(id string) error {
+	if err := os.Remove(b.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (b *fileBackend) Quarantine(id string) error {
+	dir := filepath.Join(b.dir, quarantineDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s%s.%d", id, recordExt, time.Now().UnixNano())
+	return os.Rename(b.path(id), filepath.Join(dir, name))
+}
+
+func (b *fileBackend) Close() error { return nil }
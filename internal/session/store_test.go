@@ -0,0 +1,140 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStoreVersioningAndQuarantine(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Written before records were versioned: bare metadata
+	write("legacy.json", `{"id":"legacy","binary_path":"/tmp/legacy.bin","created_at":"2025-01-02T03:04:05Z","timeout":3600000000000,"has_decompiler":false}`)
+	write("corrupt.json", `{"id":"corrupt",`)
+	write("renamed.json", `{"version":2,"session":{"id":"other","binary_path":"/tmp/other.bin"}}`)
+	write("future.json", `{"version":99,"session":{"id":"future"}}`)
+	write("partial.json.tmp", `{"id":`)
+
+	store, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metas, problems, err := store.Load()
+	if err != nil || len(metas) != 1 || metas[0].ID != "legacy" || metas[0].Timeout != time.Hour {
+		t.Fatalf("unexpected sessions: %+v %v", metas, err)
+	}
+	if len(problems) != 3 {
+		t.Fatalf("expected 3 record problems, got %v", problems)
+	}
+	for _, p := range problems {
+		if p.Quarantined != (p.ID != "future") {
+			t.Fatalf("unexpected record problem: %v", p)
+		}
+	}
+
+	// Quarantined on the first load, so only the newer record remains
+	metas, problems, err = store.Load()
+	if err != nil || len(metas) != 1 {
+		t.Fatalf("unexpected sessions on reload: %+v %v", metas, err)
+	}
+	if len(problems) != 1 || problems[0].ID != "future" || problems[0].Quarantined {
+		t.Fatalf("unexpected record problems on reload: %v", problems)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "legacy.json"))
+	if err != nil || !strings.Contains(string(data), `"version": 2`) {
+		t.Fatalf("legacy record not migrated: %s %v", data, err)
+	}
+	quarantined, err := os.ReadDir(filepath.Join(dir, "quarantine"))
+	if err != nil || len(quarantined) != 2 {
+		t.Fatalf("expected corrupt and renamed records quarantined, got %v %v", quarantined, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "future.json")); err != nil {
+		t.Fatalf("record from a newer server must be left alone: %v", err)
+	}
+}
+
+func TestStoreSaveReplacesRecord(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := NewRegistry(0).Create("/tmp/save.bin", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(sess); err != nil {
+		t.Fatal(err)
+	}
+	sess.SetDatabaseInfo(DatabaseInfo{Processor: "ARM"})
+	if err := store.Save(sess); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || entries[0].Name() != sess.ID+recordExt {
+		t.Fatalf("expected only the record to remain, got %v %v", entries, err)
+	}
+	metas, _, err := store.Load()
+	if err != nil || len(metas) != 1 || metas[0].Processor != "ARM" {
+		t.Fatalf("record not replaced: %+v %v", metas, err)
+	}
+}
+
+func TestBoltStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	store, err := OpenBoltStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := NewRegistry(0)
+	sess, err := registry.Create("/tmp/bolt.bin", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess.SetDatabaseInfo(DatabaseInfo{HasDecompiler: true, Processor: "ARM", Bitness: 32})
+	saved := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	sess.RecordSave(sess.Changes(), saved)
+	if err := store.Save(sess); err != nil {
+		t.Fatal(err)
+	}
+	gone, _ := registry.Create("/tmp/gone.bin", time.Hour)
+	if err := store.Save(gone); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(gone.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Records survive reopening the database
+	store, err = OpenBoltStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	metas, problems, err := store.Load()
+	if err != nil || len(problems) != 0 || len(metas) != 1 {
+		t.Fatalf("unexpected load: %+v %v %v", metas, problems, err)
+	}
+	m := metas[0]
+	if m.ID != sess.ID || !m.HasDecompiler || m.Processor != "ARM" || m.Bitness != 32 || !m.LastSave.Equal(saved) {
+		t.Fatalf("session not round-tripped: %+v", m)
+	}
+	restored, err := NewRegistry(0).Restore(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info := restored.DatabaseInfo(); info.Processor != "ARM" || restored.SaveStatus().LastSave != m.LastSave {
+		t.Fatalf("restored session lost its facts: %+v", info)
+	}
+}
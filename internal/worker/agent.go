@@ -0,0 +1,245 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// Agent error codes carry the worker sentinel errors across the wire.
+const (
+	codeCapacity           = "capacity"
+	codeInsufficientMemory = "insufficient_memory"
+	codeResourceLimit      = "resource_limit"
+	codeRecovering         = "recovering"
+	codeCircuitOpen        = "circuit_open"
+)
+
+var agentCodes = map[string]error{
+	codeCapacity:           ErrNoWorkerHost,
+	codeInsufficientMemory: ErrInsufficientMemory,
+	codeResourceLimit:      ErrResourceLimit,
+	codeRecovering:         ErrWorkerRecovering,
+	codeCircuitOpen:        ErrCircuitOpen,
+}
+
+// workerHostSuffix marks proxied connections; the session ID is the host label.
+const workerHostSuffix = ".worker"
+
+// HostLoad is what an agent reports for placement.
+type HostLoad struct {
+	Host                 string    `json:"host,omitempty"`
+	Sessions             int       `json:"sessions"`
+	Capacity             int       `json:"capacity"`
+	AvailableMemoryBytes uint64    `json:"available_memory_bytes"`
+	Pool                 PoolStats `json:"pool"`
+}
+
+type agentStartRequest struct {
+	SessionID  string `json:"session_id"`
+	BinaryPath string `json:"binary_path"`
+}
+
+type agentStartResponse struct {
+	PID int `json:"pid"`
+}
+
+type agentKillRequest struct {
+	Reason string `json:"reason"`
+}
+
+// agentError is an error returned by an agent, unwrapping to the matching
+// worker sentinel error.
+type agentError struct {
+	Message string `json:"error"`
+	Code    string `json:"code,omitempty"`
+}
+
+func (e *agentError) Error() string { return e.Message }
+
+func (e *agentError) Unwrap() error { return agentCodes[e.Code] }
+
+func errorCode(err error) string {
+	for code, sentinel := range agentCodes {
+		if errors.Is(err, sentinel) {
+			return code
+		}
+	}
+	return ""
+}
+
+// Agent serves a local Manager to a RemoteController on another host. It
+// exposes a small JSON control API and proxies each session's Connect
+// services under /workers/{session}/.
+type Agent struct {
+	manager  *Manager
+	capacity int
+	logger   *log.Logger
+	proxy    *httputil.ReverseProxy
+}
+
+// NewAgent creates an agent for manager. A capacity of 0 accepts any number
+// of sessions.
+func NewAgent(manager *Manager, capacity int, logger *log.Logger) *Agent {
+	a := &Agent{manager: manager, capacity: capacity, logger: logger}
+	a.proxy = &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			// Keying the host by session keeps pooled connections per worker
+			pr.Out.URL = &url.URL{
+				Scheme:   "http",
+				Host:     pr.In.PathValue("id") + workerHostSuffix,
+				Path:     "/" + pr.In.PathValue("rpc"),
+				RawQuery: pr.In.URL.RawQuery,
+			}
+			pr.Out.Host = ""
+		},
+		Transport: &http.Transport{
+			DialContext: func(_ context.Context, _, addr string) (net.Conn, error) {
+				host, _, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				return manager.dial(strings.TrimSuffix(host, workerHostSuffix))
+			},
+		},
+		// Flush immediately so StatusStream messages are not buffered
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			logger.Printf("[Agent] Proxy to session %s failed: %v", r.PathValue("id"), err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	return a
+}
+
+// Handler returns the agent's HTTP handler.
+func (a *Agent) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /agent/v1/sessions", a.startSession)
+	mux.HandleFunc("DELETE /agent/v1/sessions/{id}", a.stopSession)
+	mux.HandleFunc("POST /agent/v1/sessions/{id}/kill", a.killSession)
+	mux.HandleFunc("GET /agent/v1/sessions/{id}", a.sessionInfo)
+	mux.HandleFunc("GET /agent/v1/sessions/{id}/logs", a.sessionLogs)
+	mux.HandleFunc("GET /agent/v1/load", a.load)
+	mux.Handle("/workers/{id}/{rpc...}", a.proxy)
+	return mux
+}
+
+// Shutdown stops the pool and every session worker.
+func (a *Agent) Shutdown() {
+	a.manager.Close()
+	for _, id := range a.manager.sessionIDs() {
+		if err := a.manager.Stop(id); err != nil {
+			a.logger.Printf("[Agent] Failed to stop session %s: %v", id, err)
+		}
+	}
+}
+
+func (a *Agent) startSession(w http.ResponseWriter, r *http.Request) {
+	var req agentStartRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.SessionID == "" || req.BinaryPath == "" {
+		writeAgentError(w, http.StatusBadRequest, &agentError{Message: "session_id and binary_path are required"})
+		return
+	}
+
+	if slices.Contains(a.manager.sessionIDs(), req.SessionID) {
+		// The front end restarted and is reopening a session we still run
+		a.logger.Printf("[Agent] Replacing existing worker for session %s", req.SessionID)
+		a.manager.Stop(req.SessionID)
+	}
+	if a.capacity > 0 && len(a.manager.sessionIDs()) >= a.capacity {
+		writeAgentError(w, http.StatusServiceUnavailable, &agentError{
+			Message: fmt.Sprintf("agent at capacity (%d sessions)", a.capacity),
+			Code:    codeCapacity,
+		})
+		return
+	}
+
+	sess := &session.Session{ID: req.SessionID, BinaryPath: req.BinaryPath}
+	if err := a.manager.Start(r.Context(), sess, req.BinaryPath); err != nil {
+		status := http.StatusInternalServerError
+		code := errorCode(err)
+		if code != "" {
+			status = http.StatusServiceUnavailable
+		}
+		writeAgentError(w, status, &agentError{Message: err.Error(), Code: code})
+		return
+	}
+	a.logger.Printf("[Agent] Started session %s (PID %d) for %s", sess.ID, sess.WorkerPID, req.BinaryPath)
+	writeAgentJSON(w, agentStartResponse{PID: sess.WorkerPID})
+}
+
+func (a *Agent) stopSession(w http.ResponseWriter, r *http.Request) {
+	if err := a.manager.Stop(r.PathValue("id")); err != nil {
+		writeAgentError(w, http.StatusNotFound, &agentError{Message: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *Agent) killSession(w http.ResponseWriter, r *http.Request) {
+	var req agentKillRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAgentError(w, http.StatusBadRequest, &agentError{Message: "invalid kill request"})
+		return
+	}
+	if err := a.manager.Kill(r.PathValue("id"), req.Reason); err != nil {
+		writeAgentError(w, http.StatusNotFound, &agentError{Message: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *Agent) sessionInfo(w http.ResponseWriter, r *http.Request) {
+	writeAgentJSON(w, a.manager.Info(r.PathValue("id")))
+}
+
+func (a *Agent) sessionLogs(w http.ResponseWriter, r *http.Request) {
+	query := LogQuery{MinLevel: r.URL.Query().Get("level")}
+	if tail := r.URL.Query().Get("tail"); tail != "" {
+		query.Tail, _ = strconv.Atoi(tail)
+	}
+	if since := r.URL.Query().Get("since"); since != "" {
+		query.Since, _ = time.Parse(time.RFC3339Nano, since)
+	}
+	entries := a.manager.Logs(r.PathValue("id"), query)
+	if entries == nil {
+		entries = []LogEntry{}
+	}
+	writeAgentJSON(w, entries)
+}
+
+func (a *Agent) load(w http.ResponseWriter, r *http.Request) {
+	load := HostLoad{
+		Sessions: len(a.manager.sessionIDs()),
+		Capacity: a.capacity,
+		Pool:     a.manager.PoolStats(),
+	}
+	if available, ok := availableMemory(); ok {
+		load.AvailableMemoryBytes = available
+	}
+	writeAgentJSON(w, load)
+}
+
+func writeAgentJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeAgentError(w http.ResponseWriter, status int, err *agentError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(err)
+}
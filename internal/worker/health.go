@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+)
+
+const (
+	defaultHealthInterval = 15 * time.Second
+	defaultPingTimeout    = 5 * time.Second
+	defaultHungAfter      = 2
+)
+
+// Health is the latest liveness and metrics snapshot for a worker.
+type Health struct {
+	MemoryBytes     uint64    `json:"memory_bytes"`
+	Dirty           bool      `json:"dirty"`
+	PendingRequests uint32    `json:"pending_requests"`
+	LastActivity    time.Time `json:"last_activity,omitzero"`
+	StatusAt        time.Time `json:"status_at,omitzero"`
+	LastPing        time.Time `json:"last_ping,omitzero"`
+	PingFailures    int       `json:"ping_failures"`
+	LastPingError   string    `json:"last_ping_error,omitempty"`
+	// Hung is set when consecutive pings time out while the process is alive.
+	Hung bool `json:"hung"`
+}
+
+// healthMonitor tracks the status stream and ping results for one worker.
+type healthMonitor struct {
+	mu     sync.Mutex
+	health Health
+}
+
+func (h *healthMonitor) snapshot() Health {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.health
+}
+
+func (h *healthMonitor) hung() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.health.Hung
+}
+
+// watchHealth subscribes to the worker's status stream and pings it until the
+// worker context is cancelled.
+func (m *Manager) watchHealth(sessionID string, worker *WorkerClient) {
+	go m.streamStatus(sessionID, worker)
+
+	ticker := time.NewTicker(m.healthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-worker.ctx.Done():
+			return
+		case <-ticker.C:
+			m.ping(sessionID, worker)
+		}
+	}
+}
+
+func (m *Manager) ping(sessionID string, worker *WorkerClient) {
+	ctx, cancel := context.WithTimeout(worker.ctx, m.pingTimeout)
+	defer cancel()
+	_, err := (*worker.Health).Ping(ctx, connect.NewRequest(&pb.PingRequest{}))
+	if worker.ctx.Err() != nil {
+		return
+	}
+
+	h := worker.health
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err == nil {
+		if h.health.Hung {
+			m.logger.Printf("[Health] Session %s worker responding again", sessionID)
+		}
+		h.health.LastPing = time.Now()
+		h.health.PingFailures = 0
+		h.health.LastPingError = ""
+		h.health.Hung = false
+		return
+	}
+	h.health.PingFailures++
+	h.health.LastPingError = err.Error()
+	if !h.health.Hung && h.health.PingFailures >= m.hungAfter {
+		h.health.Hung = true
+		m.logger.Printf("[Health] Session %s worker PID %d not responding (%d failed pings): %v",
+			sessionID, worker.cmd.Process.Pid, h.health.PingFailures, err)
+	}
+}
+
+// streamStatus keeps the latest WorkerStatus, resubscribing whenever the
+// stream ends while the worker is still running.
+func (m *Manager) streamStatus(sessionID string, worker *WorkerClient) {
+	interval := uint32(m.healthInterval / time.Second)
+	if interval == 0 {
+		interval = 1
+	}
+	for {
+		stream, err := (*worker.Health).StatusStream(worker.ctx, connect.NewRequest(&pb.StatusStreamRequest{
+			IntervalSeconds: interval,
+		}))
+		if err == nil {
+			for stream.Receive() {
+				worker.health.record(stream.Msg())
+				m.enforceMemoryLimit(sessionID, worker, stream.Msg().GetMemoryBytes())
+			}
+			stream.Close()
+		}
+
+		select {
+		case <-worker.ctx.Done():
+			return
+		case <-time.After(m.healthInterval):
+		}
+	}
+}
+
+func (h *healthMonitor) record(status *pb.WorkerStatus) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.health.MemoryBytes = status.GetMemoryBytes()
+	h.health.Dirty = status.GetDirty()
+	h.health.PendingRequests = status.GetPendingRequests()
+	if ts := status.GetLastActivity(); ts > 0 {
+		h.health.LastActivity = time.Unix(ts, 0)
+	}
+	h.health.StatusAt = time.Now()
+}
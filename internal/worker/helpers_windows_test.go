@@ -13,25 +13,69 @@ import (
 )
 
 
-// writeFakeWorker writes a minimal Python worker that listens on a TCP loopback port.
+// writeFakeWorker writes a minimal Python worker that answers every RPC with
+// success=true. It listens on a TCP loopback port.
 func writeFakeWorker(t *testing.T) string {
 	t.Helper()
-	script := `import argparse, socket, time, signal, sys
+	script := `import argparse, os, socket, time, signal, sys
 parser = argparse.ArgumentParser()
 parser.add_argument("--port", required=True, type=int)
-parser.add_argument("--binary", required=True)
+parser.add_argument("--binary")
+parser.add_argument("--pool", action="store_true")
 parser.add_argument("--session-id", required=True)
 args = parser.parse_args()
 sock = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
 sock.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
 sock.bind(('127.0.0.1', args.port))
 sock.listen(1)
+log_prefix = "[Worker %s] 2026-01-01 00:00:00,000" % args.session_id
+print(log_prefix + " - INFO - Listening", file=sys.stderr)
+print(log_prefix + " - ERROR - Simulated failure", file=sys.stderr)
+print("Traceback (most recent call last):", file=sys.stderr, flush=True)
+def respond(conn):
+    # Any proto response whose field 1 is true reads as success
+    data = b""
+    while b"\r\n\r\n" not in data:
+        chunk = conn.recv(4096)
+        if not chunk:
+            return
+        data += chunk
+    head, _, body = data.partition(b"\r\n\r\n")
+    path = head.split(b"\r\n")[0].split(b" ")[1]
+    headers = {}
+    for line in head.split(b"\r\n")[1:]:
+        name, _, value = line.partition(b":")
+        headers[name.strip().lower()] = value.strip()
+    if headers.get(b"transfer-encoding") == b"chunked":
+        while not body.endswith(b"0\r\n\r\n"):
+            chunk = conn.recv(4096)
+            if not chunk:
+                break
+            body += chunk
+    else:
+        length = int(headers.get(b"content-length", b"0"))
+        while len(body) < length:
+            chunk = conn.recv(4096)
+            if not chunk:
+                break
+            body += chunk
+    if os.environ.get("FAKE_WORKER_HANG") and path.endswith(b"/Ping"):
+        time.sleep(3600)
+    if os.environ.get("FAKE_WORKER_OOM") and path.endswith(b"/StatusStream"):
+        os._exit(12)
+    content_type, payload = b"application/proto", b"\x08\x01"
+    if path.endswith(b"/StatusStream"):
+        # One WorkerStatus{memory_bytes: 8 GiB, pending_requests: 1}, then end of stream
+        content_type = b"application/connect+proto"
+        payload = b"\x00\x00\x00\x00\x08\x10\x80\x80\x80\x80\x20\x28\x01" + b"\x02\x00\x00\x00\x02{}"
+    conn.sendall(b"HTTP/1.1 200 OK\r\nContent-Type: " + content_type + b"\r\nContent-Length: " + str(len(payload)).encode() + b"\r\nConnection: close\r\n\r\n" + payload)
 def handle_signal(signum, frame):
     sys.exit(0)
 signal.signal(signal.SIGINT, handle_signal)
 while True:
     try:
         conn, _ = sock.accept()
+        respond(conn)
         conn.close()
     except Exception:
         time.sleep(0.1)
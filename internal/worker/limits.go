@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+var (
+	// ErrResourceLimit is returned for sessions whose worker was killed for
+	// exceeding its memory limit.
+	ErrResourceLimit = errors.New("worker exceeded its resource limit")
+	// ErrInsufficientMemory is returned when host memory stays below the
+	// admission threshold.
+	ErrInsufficientMemory = errors.New("insufficient host memory")
+)
+
+// Limits bounds the resources of each worker process. Zero values mean unlimited.
+type Limits struct {
+	MemoryBytes uint64
+	// CPUPercent caps CPU time as a share of one core (200 = two cores).
+	// Only enforced where cgroup v2 is available.
+	CPUPercent int
+}
+
+// Admission controls when new workers may start.
+type Admission struct {
+	// MinFreeBytes is the host memory that must be available to start a worker.
+	MinFreeBytes uint64
+	// Wait is how long Start queues for memory to free up before refusing.
+	Wait time.Duration
+}
+
+const admissionPoll = time.Second
+
+// SetLimits sets the resource limits applied to workers started from now on.
+func (m *Manager) SetLimits(limits Limits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.limits = limits
+}
+
+// SetAdmission sets the host memory admission policy for new workers.
+func (m *Manager) SetAdmission(admission Admission) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.admission = admission
+}
+
+// admit blocks until the host has enough available memory for another worker,
+// the admission wait elapses, or ctx is cancelled.
+func (m *Manager) admit(ctx context.Context, sessionID string) error {
+	m.mu.RLock()
+	policy := m.admission
+	m.mu.RUnlock()
+	if policy.MinFreeBytes == 0 {
+		return nil
+	}
+
+	deadline := time.Now().Add(policy.Wait)
+	logged := false
+	for {
+		available, ok := availableMemory()
+		if !ok || available >= policy.MinFreeBytes {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("%w: %d MiB available, %d MiB required", ErrInsufficientMemory,
+				available>>20, policy.MinFreeBytes>>20)
+		}
+		if !logged {
+			m.logger.Printf("[Worker] Session %s waiting for host memory (%d MiB available, %d MiB required)",
+				sessionID, available>>20, policy.MinFreeBytes>>20)
+			logged = true
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(admissionPoll):
+		}
+	}
+}
+
+// enforceMemoryLimit kills a worker whose reported memory exceeds the limit.
+// Kernel limits are not available everywhere, so this backs them up using
+// the figures from the status stream.
+func (m *Manager) enforceMemoryLimit(sessionID string, worker *WorkerClient, memoryBytes uint64) {
+	limit := worker.limits.MemoryBytes
+	if limit == 0 || memoryBytes <= limit {
+		return
+	}
+	reason := fmt.Sprintf("memory %d MiB exceeded limit of %d MiB", memoryBytes>>20, limit>>20)
+	m.mu.Lock()
+	if worker.limitReason == "" {
+		worker.limitReason = reason
+	}
+	m.mu.Unlock()
+	m.logger.Printf("[Worker] Killing session %s PID %d: %s", sessionID, worker.cmd.Process.Pid, reason)
+	if err := worker.cmd.Process.Kill(); err != nil {
+		m.logger.Printf("[Worker] Failed to kill PID %d: %v", worker.cmd.Process.Pid, err)
+	}
+}
+
+// exitOutOfMemory is the exit status of a worker whose allocation failed
+// under its memory limit (ENOMEM); see EXIT_OUT_OF_MEMORY in server.py.
+const exitOutOfMemory = 12
+
+// exitLimitReason reports why an exited worker was stopped by its limits, or
+// "" if there is no evidence the exit was limit related. waitErr is the
+// result of waiting on the worker process.
+func (m *Manager) exitLimitReason(worker *WorkerClient, waitErr error) string {
+	m.mu.RLock()
+	reason := worker.limitReason
+	m.mu.RUnlock()
+	if reason != "" {
+		return reason
+	}
+	if worker.guard != nil && worker.guard.oomKilled() {
+		return fmt.Sprintf("killed by the kernel for exceeding the %d MiB memory limit", worker.limits.MemoryBytes>>20)
+	}
+	// Under rlimits an allocation fails inside the worker instead
+	var exitErr *exec.ExitError
+	if limit := worker.limits.MemoryBytes; limit > 0 && errors.As(waitErr, &exitErr) && exitErr.ExitCode() == exitOutOfMemory {
+		return fmt.Sprintf("ran out of memory under its %d MiB limit", limit>>20)
+	}
+	return ""
+}
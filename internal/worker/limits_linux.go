@@ -0,0 +1,204 @@
+//go:build linux
+
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+const cgroupRoot = "/sys/fs/cgroup"
+
+// serverCgroupLeaf is the child group the server moves itself into so its
+// own cgroup can hand controllers to worker groups.
+const serverCgroupLeaf = "ida-mcp-server"
+
+// resourceGuard records how a worker's limits are enforced by the kernel.
+type resourceGuard struct {
+	cgroupDir string
+	mechanism string
+}
+
+// applyLimits places the worker in its own cgroup v2 group when the server's
+// cgroup is delegated to it, and falls back to RLIMIT_DATA otherwise. CPU
+// limits need cgroups; without them only memory is enforced.
+func applyLimits(sessionID string, pid int, limits Limits) (*resourceGuard, error) {
+	if limits.MemoryBytes == 0 && limits.CPUPercent == 0 {
+		return nil, nil
+	}
+
+	dir, cgErr := createWorkerCgroup(sessionID, limits)
+	if cgErr == nil {
+		err := os.WriteFile(filepath.Join(dir, "cgroup.procs"), []byte(strconv.Itoa(pid)), 0o644)
+		if err == nil {
+			return &resourceGuard{cgroupDir: dir, mechanism: "cgroup"}, nil
+		}
+		cgErr = err
+		os.Remove(dir)
+	}
+
+	guard := &resourceGuard{mechanism: "watchdog"}
+	var errs []error
+	if limits.MemoryBytes > 0 {
+		rlim := &unix.Rlimit{Cur: limits.MemoryBytes, Max: limits.MemoryBytes}
+		if err := unix.Prlimit(pid, unix.RLIMIT_DATA, rlim, nil); err != nil {
+			errs = append(errs, fmt.Errorf("RLIMIT_DATA: %w", err))
+		} else {
+			guard.mechanism = "rlimit"
+		}
+	}
+	if limits.CPUPercent > 0 {
+		errs = append(errs, fmt.Errorf("cpu limit needs cgroup v2: %w", cgErr))
+	}
+	return guard, errors.Join(errs...)
+}
+
+// workerCgroupParent is the cgroup worker groups are created in, resolved
+// once: the server's own cgroup, emptied into a leaf.
+var workerCgroupParent = sync.OnceValues(delegatedCgroup)
+
+// delegatedCgroup returns the server's cgroup after moving its processes
+// into a serverCgroupLeaf child. cgroup v2 lets a non-root group enable
+// controllers for its children only while it has no processes of its own,
+// so the group must be delegated to the server, as systemd's Delegate=yes
+// or a container's private cgroup namespace do.
+func delegatedCgroup() (string, error) {
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+	var self string
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(line, "0::"); ok {
+			self = rest
+			break
+		}
+	}
+	if self == "" {
+		return "", errors.New("cgroup v2 not mounted")
+	}
+	parent := filepath.Join(cgroupRoot, self)
+	if self == "/" {
+		// The root group may have processes and children both
+		return parent, nil
+	}
+
+	procs, err := os.ReadFile(filepath.Join(parent, "cgroup.procs"))
+	if err != nil {
+		return "", err
+	}
+	leaf := filepath.Join(parent, serverCgroupLeaf)
+	if err := os.Mkdir(leaf, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return "", err
+	}
+	for _, pid := range strings.Fields(string(procs)) {
+		if err := os.WriteFile(filepath.Join(leaf, "cgroup.procs"), []byte(pid), 0o644); err != nil {
+			return "", fmt.Errorf("move PID %s into %s: %w", pid, leaf, err)
+		}
+	}
+	return parent, nil
+}
+
+// createWorkerCgroup creates a worker group next to the server's leaf with
+// the memory and cpu controllers configured.
+func createWorkerCgroup(sessionID string, limits Limits) (string, error) {
+	parent, err := workerCgroupParent()
+	if err != nil {
+		return "", err
+	}
+	want := []string{}
+	if limits.MemoryBytes > 0 {
+		want = append(want, "memory")
+	}
+	if limits.CPUPercent > 0 {
+		want = append(want, "cpu")
+	}
+	enabled, err := os.ReadFile(filepath.Join(parent, "cgroup.subtree_control"))
+	if err != nil {
+		return "", err
+	}
+	for _, ctrl := range want {
+		if !bytes.Contains(enabled, []byte(ctrl)) {
+			if err := os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte("+"+ctrl), 0o644); err != nil {
+				return "", fmt.Errorf("enable %s controller: %w", ctrl, err)
+			}
+		}
+	}
+
+	dir := filepath.Join(parent, "ida-worker-"+sessionID)
+	if err := os.Mkdir(dir, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return "", err
+	}
+	if limits.MemoryBytes > 0 {
+		if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(strconv.FormatUint(limits.MemoryBytes, 10)), 0o644); err != nil {
+			os.Remove(dir)
+			return "", err
+		}
+	}
+	if limits.CPUPercent > 0 {
+		const period = 100000
+		quota := fmt.Sprintf("%d %d", limits.CPUPercent*period/100, period)
+		if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(quota), 0o644); err != nil {
+			os.Remove(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// oomKilled reports whether the kernel OOM killer fired inside the worker's cgroup.
+func (g *resourceGuard) oomKilled() bool {
+	if g.cgroupDir == "" {
+		return false
+	}
+	data, err := os.ReadFile(filepath.Join(g.cgroupDir, "memory.events"))
+	if err != nil {
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if count, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			n, _ := strconv.Atoi(count)
+			return n > 0
+		}
+	}
+	return false
+}
+
+// release removes the worker's cgroup once its process has exited.
+func (g *resourceGuard) release() {
+	if g.cgroupDir != "" {
+		os.Remove(g.cgroupDir)
+	}
+}
+
+// availableMemory reports MemAvailable from /proc/meminfo.
+func availableMemory() (uint64, bool) {
+	data, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(line, "MemAvailable:"); ok {
+			fields := strings.Fields(rest)
+			if len(fields) == 0 {
+				return 0, false
+			}
+			kb, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			return kb * 1024, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,32 @@
+//go:build !linux
+
+package worker
+
+import "errors"
+
+// resourceGuard records how a worker's limits are enforced by the kernel.
+type resourceGuard struct {
+	mechanism string
+}
+
+// applyLimits has no kernel mechanism outside Linux; memory limits are still
+// enforced by the manager from the worker's status stream.
+func applyLimits(sessionID string, pid int, limits Limits) (*resourceGuard, error) {
+	if limits.MemoryBytes == 0 && limits.CPUPercent == 0 {
+		return nil, nil
+	}
+	guard := &resourceGuard{mechanism: "watchdog"}
+	if limits.CPUPercent > 0 {
+		return guard, errors.New("cpu limits are only supported on Linux")
+	}
+	return guard, nil
+}
+
+func (g *resourceGuard) oomKilled() bool { return false }
+
+func (g *resourceGuard) release() {}
+
+// availableMemory is not implemented on this platform; admission checks are skipped.
+func availableMemory() (uint64, bool) {
+	return 0, false
+}
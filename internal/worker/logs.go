@@ -0,0 +1,329 @@
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLogLines    = 2000
+	defaultLogMaxBytes = 5 << 20
+	defaultLogMaxFiles = 3
+	maxLogLineBytes    = 64 << 10
+)
+
+// LogConfig controls how worker output is captured.
+type LogConfig struct {
+	// Lines is the per-session ring buffer capacity.
+	Lines int
+	// Dir, when set, mirrors each session's log to Dir/<session>.log.
+	Dir string
+	// MaxFileBytes rotates the file once it grows past this size, keeping
+	// MaxFiles rotated copies.
+	MaxFileBytes int64
+	MaxFiles     int
+}
+
+// LogEntry is one line of worker output.
+type LogEntry struct {
+	Time   time.Time `json:"time"`
+	Stream string    `json:"stream"`
+	Level  string    `json:"level"`
+	Line   string    `json:"line"`
+}
+
+// LogQuery filters the lines returned by Logs.
+type LogQuery struct {
+	Tail     int
+	MinLevel string
+	Since    time.Time
+}
+
+var logLevels = []string{"DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL"}
+
+func levelRank(level string) int {
+	for i, l := range logLevels {
+		if strings.EqualFold(l, level) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetLogConfig sets how output of workers started from now on is captured.
+func (m *Manager) SetLogConfig(cfg LogConfig) {
+	if cfg.Lines <= 0 {
+		cfg.Lines = defaultLogLines
+	}
+	if cfg.MaxFileBytes <= 0 {
+		cfg.MaxFileBytes = defaultLogMaxBytes
+	}
+	if cfg.MaxFiles <= 0 {
+		cfg.MaxFiles = defaultLogMaxFiles
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logConfig = cfg
+}
+
+// Logs returns captured worker output for a session, oldest first. Output of
+// a crashed worker stays available until the session is stopped.
+func (m *Manager) Logs(sessionID string, query LogQuery) []LogEntry {
+	m.mu.RLock()
+	buf := m.logs[sessionID]
+	m.mu.RUnlock()
+	if buf == nil {
+		return nil
+	}
+	return buf.query(query)
+}
+
+// sessionLog returns the log buffer for id, creating it if needed.
+func (m *Manager) sessionLog(id string) *logBuffer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if buf, ok := m.logs[id]; ok {
+		return buf
+	}
+	buf := newLogBuffer(id, m.logConfig)
+	m.logs[id] = buf
+	return buf
+}
+
+// dropLog forgets the captured output of a stopped session. A mirrored log
+// file stays on disk.
+func (m *Manager) dropLog(id string) {
+	m.mu.Lock()
+	buf := m.logs[id]
+	delete(m.logs, id)
+	m.mu.Unlock()
+	if buf != nil {
+		buf.close()
+	}
+}
+
+// attachLogs moves a pool worker's output into the session's buffer, keeping
+// its startup lines ahead of anything it prints from now on.
+func attachLogs(worker *WorkerClient, buf *logBuffer) {
+	if worker.logs == buf {
+		return
+	}
+	worker.stdout.mu.Lock()
+	defer worker.stdout.mu.Unlock()
+	worker.stderr.mu.Lock()
+	defer worker.stderr.mu.Unlock()
+	buf.absorb(worker.logs)
+	worker.stdout.target = buf
+	worker.stderr.target = buf
+	worker.logs = buf
+}
+
+// logBuffer is a bounded ring of log lines, optionally mirrored to a file.
+type logBuffer struct {
+	mu      sync.Mutex
+	entries []LogEntry
+	next    int
+	full    bool
+	level   string // level of the last parsed line, inherited by continuations
+	file    *rotatingFile
+}
+
+func newLogBuffer(id string, cfg LogConfig) *logBuffer {
+	lines := cfg.Lines
+	if lines <= 0 {
+		lines = defaultLogLines
+	}
+	buf := &logBuffer{entries: make([]LogEntry, lines), level: "INFO"}
+	if cfg.Dir != "" {
+		buf.file = &rotatingFile{
+			path:     filepath.Join(cfg.Dir, id+".log"),
+			maxBytes: cfg.MaxFileBytes,
+			maxFiles: cfg.MaxFiles,
+		}
+	}
+	return buf
+}
+
+func (b *logBuffer) add(stream, line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	level := parseLevel(line)
+	if level == "" {
+		level = b.level
+	} else {
+		b.level = level
+	}
+	entry := LogEntry{Time: time.Now(), Stream: stream, Level: level, Line: line}
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+	if b.file != nil {
+		b.file.write(fmt.Sprintf("%s %s %s\n", entry.Time.Format(time.RFC3339Nano), stream, line))
+	}
+}
+
+// absorb appends the lines of another buffer, used when a pool worker's
+// startup output joins the session it is assigned to.
+func (b *logBuffer) absorb(other *logBuffer) {
+	for _, entry := range other.query(LogQuery{}) {
+		b.add(entry.Stream, entry.Line)
+	}
+}
+
+func (b *logBuffer) query(q LogQuery) []LogEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var ordered []LogEntry
+	if b.full {
+		ordered = append(ordered, b.entries[b.next:]...)
+	}
+	ordered = append(ordered, b.entries[:b.next]...)
+
+	minRank := levelRank(q.MinLevel)
+	result := make([]LogEntry, 0, len(ordered))
+	for _, entry := range ordered {
+		if !q.Since.IsZero() && entry.Time.Before(q.Since) {
+			continue
+		}
+		if minRank > 0 && levelRank(entry.Level) < minRank {
+			continue
+		}
+		result = append(result, entry)
+	}
+	if q.Tail > 0 && len(result) > q.Tail {
+		result = result[len(result)-q.Tail:]
+	}
+	return result
+}
+
+func (b *logBuffer) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.file != nil {
+		b.file.close()
+		b.file = nil
+	}
+}
+
+// parseLevel extracts the level from the worker's
+// "[Worker id] time - LEVEL - message" log format.
+func parseLevel(line string) string {
+	for _, level := range logLevels {
+		if strings.Contains(line, " - "+level+" - ") {
+			return level
+		}
+	}
+	return ""
+}
+
+// logWriter splits a worker output stream into lines for a log buffer and
+// copies the raw output to echo.
+type logWriter struct {
+	mu      sync.Mutex
+	stream  string
+	target  *logBuffer
+	echo    io.Writer
+	partial []byte
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.echo != nil {
+		w.echo.Write(p)
+	}
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.target.add(w.stream, strings.TrimRight(string(w.partial[:i]), "\r"))
+		w.partial = w.partial[i+1:]
+	}
+	if len(w.partial) > maxLogLineBytes {
+		w.target.add(w.stream, string(w.partial))
+		w.partial = nil
+	}
+	return len(p), nil
+}
+
+// flush records a trailing line the worker wrote without a newline.
+func (w *logWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.partial) > 0 {
+		w.target.add(w.stream, string(w.partial))
+		w.partial = nil
+	}
+}
+
+// rotatingFile appends to path and rotates it to path.1 … path.N once it
+// grows past maxBytes. Write errors disable the file rather than the buffer.
+type rotatingFile struct {
+	path     string
+	maxBytes int64
+	maxFiles int
+	f        *os.File
+	size     int64
+	failed   bool
+}
+
+func (r *rotatingFile) write(line string) {
+	if r.failed {
+		return
+	}
+	if r.f == nil {
+		if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+			r.failed = true
+			return
+		}
+		f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			r.failed = true
+			return
+		}
+		info, _ := f.Stat()
+		r.f = f
+		if info != nil {
+			r.size = info.Size()
+		}
+	}
+	n, err := r.f.WriteString(line)
+	if err != nil {
+		r.failed = true
+		return
+	}
+	r.size += int64(n)
+	if r.size >= r.maxBytes {
+		r.rotate()
+	}
+}
+
+func (r *rotatingFile) rotate() {
+	r.close()
+	for i := r.maxFiles - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if r.maxFiles > 0 {
+		os.Rename(r.path, r.path+".1")
+	} else {
+		os.Remove(r.path)
+	}
+}
+
+func (r *rotatingFile) close() {
+	if r.f != nil {
+		r.f.Close()
+		r.f = nil
+		r.size = 0
+	}
+}
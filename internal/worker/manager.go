@@ -5,7 +5,6 @@ import (
 	"errors"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"net"
 	"net/http"
@@ -22,12 +21,94 @@ import (
 	"github.com/zboralski/ida-headless-mcp/ida/worker/v1/workerconnect"
 )
 
+const (
+	defaultMaxCrashes     = 3
+	defaultCrashWindow    = 10 * time.Minute
+	defaultRestartBackoff = time.Second
+	maxRestartBackoff     = 30 * time.Second
+	reopenTimeout         = 2 * time.Minute
+)
+
+var (
+	// ErrWorkerRecovering is returned while a crashed worker is being restarted.
+	ErrWorkerRecovering = errors.New("worker crashed and is restarting")
+	// ErrCircuitOpen is returned once a session crashed too often to be restarted.
+	ErrCircuitOpen = errors.New("worker restart circuit breaker open")
+)
+
 // Manager handles Python worker processes
 type Manager struct {
 	pythonScript string
 	sessions     map[string]*WorkerClient
+	recovery     map[string]*recoveryState
+	onRecovery   func(RecoveryEvent)
 	logger       *log.Logger
 	mu           sync.RWMutex
+
+	// Crash recovery policy: more than maxCrashes unexpected exits within
+	// crashWindow opens the circuit breaker for the session.
+	maxCrashes     int
+	crashWindow    time.Duration
+	restartBackoff time.Duration
+
+	// Health monitoring: a worker is flagged hung after hungAfter consecutive
+	// pings fail to answer within pingTimeout.
+	healthInterval time.Duration
+	pingTimeout    time.Duration
+	hungAfter      int
+
+	limits    Limits
+	admission Admission
+	pool      workerPool
+
+	// Captured worker output per session, kept across crashes until Stop
+	logConfig LogConfig
+	logs      map[string]*logBuffer
+}
+
+// RestartEvent records one automatic restart attempt after a worker crash.
+type RestartEvent struct {
+	At      time.Time `json:"at"`
+	Reason  string    `json:"reason"`
+	Success bool      `json:"success"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// RecoveryEvent is delivered to the recovery hook after a crash is handled.
+type RecoveryEvent struct {
+	SessionID   string
+	Recovered   bool
+	CircuitOpen bool
+	Err         error
+}
+
+// Info summarises the worker state for a session.
+type Info struct {
+	PID         int            `json:"pid"`
+	Running     bool           `json:"running"`
+	Crashes     int            `json:"crashes"`
+	Recovering  bool           `json:"recovering"`
+	CircuitOpen bool           `json:"circuit_open"`
+	Restarts    []RestartEvent `json:"restarts,omitempty"`
+	Health      *Health        `json:"health,omitempty"`
+	// Pooled is set when the worker came pre-warmed from the pool.
+	Pooled    bool    `json:"pooled"`
+	StartupMs float64 `json:"startup_ms"`
+	// LimitExceeded explains why the worker was killed by its resource limits.
+	LimitExceeded    string `json:"limit_exceeded,omitempty"`
+	LimitEnforcement string `json:"limit_enforcement,omitempty"`
+	// Host is the agent running the worker when it is remote.
+	Host string `json:"host,omitempty"`
+}
+
+type recoveryState struct {
+	crashes     []time.Time
+	total       int
+	restarts    []RestartEvent
+	recovering  bool
+	circuitOpen bool
+	stopped     bool
+	limitReason string
 }
 
 // WorkerClient wraps Connect clients for a session
@@ -39,7 +120,20 @@ type WorkerClient struct {
 	cancel      context.CancelFunc
 	ctx         context.Context
 	session     *session.Session
+	sessionID   string // empty while the worker idles in the pool
 	binaryPath  string
+	addr        string
+	startup     time.Duration
+	pooled      bool
+	exited      chan struct{} // closed by monitorWorker once the process is reaped
+	health      *healthMonitor
+	limits      Limits
+	guard       *resourceGuard
+	limitReason string // set when the worker is killed for exceeding its limits
+	killReason  string // set when Kill stops a stuck worker
+	logs        *logBuffer
+	stdout      *logWriter
+	stderr      *logWriter
 }
 
 // Controller captures the worker operations required by the server.
@@ -49,17 +143,43 @@ type Controller interface {
 	GetClient(sessionID string) (*WorkerClient, error)
 	CleanupOrphanSockets() int
 	CleanupOrphanProcesses() int
+	Info(sessionID string) Info
+	PoolStats() PoolStats
+	Logs(sessionID string, query LogQuery) []LogEntry
+	Kill(sessionID, reason string) error
+	// LocalFilesystem reports whether workers see the server's filesystem,
+	// so the server can place and copy their database files.
+	LocalFilesystem() bool
 }
 
 // NewManager creates worker manager
 func NewManager(pythonScript string, logger *log.Logger) *Manager {
 	return &Manager{
-		pythonScript: pythonScript,
-		sessions:     make(map[string]*WorkerClient),
-		logger:       logger,
+		pythonScript:   pythonScript,
+		sessions:       make(map[string]*WorkerClient),
+		recovery:       make(map[string]*recoveryState),
+		logs:           make(map[string]*logBuffer),
+		logger:         logger,
+		maxCrashes:     defaultMaxCrashes,
+		crashWindow:    defaultCrashWindow,
+		restartBackoff: defaultRestartBackoff,
+		healthInterval: defaultHealthInterval,
+		pingTimeout:    defaultPingTimeout,
+		hungAfter:      defaultHungAfter,
 	}
 }
 
+// OnRecovery registers a hook invoked after a crashed worker was restarted,
+// or after the circuit breaker gave up on it.
+func (m *Manager) OnRecovery(hook func(RecoveryEvent)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onRecovery = hook
+}
+
+// LocalFilesystem is true: workers run on this host.
+func (m *Manager) LocalFilesystem() bool { return true }
+
 // findPython returns the first Python executable found on PATH.
 func findPython() string {
 	for _, name := range []string{"python3", "python", "py"} {
@@ -70,17 +190,56 @@ func findPython() string {
 	return "python3" // fallback: let the OS surface the error
 }
 
-// Start spawns a Python worker for the session.
+// Start assigns a worker to the session, taking a pre-warmed one from the
+// pool when available and spawning a new one otherwise.
 // The IPC transport (Unix socket or TCP) is chosen automatically per OS.
 func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath string) error {
+	worker := m.takeIdle(ctx, sess.ID, binaryPath, sess.DatabasePath)
+	if worker == nil {
+		if err := m.admit(ctx, sess.ID); err != nil {
+			return err
+		}
+		m.mu.RLock()
+		limits := m.limits
+		m.mu.RUnlock()
+
+		var err error
+		worker, err = m.spawn(sess.ID, binaryPath, sess.DatabasePath, limits)
+		if err != nil {
+			return err
+		}
+	}
+
+	attachLogs(worker, m.sessionLog(sess.ID))
+
+	sess.SocketPath = worker.addr
+	sess.WorkerPID = worker.cmd.Process.Pid
+
+	m.mu.Lock()
+	worker.sessionID = sess.ID
+	worker.session = sess
+	worker.binaryPath = binaryPath
+	m.sessions[sess.ID] = worker
+	m.mu.Unlock()
+
+	go m.watchHealth(sess.ID, worker)
+
+	return nil
+}
+
+// spawn launches a worker process and waits until it accepts connections.
+// An empty binaryPath starts an unassigned pool worker. A non-empty
+// databasePath tells the worker where to keep the IDA database.
+func (m *Manager) spawn(id, binaryPath, databasePath string, limits Limits) (*WorkerClient, error) {
+	started := time.Now()
+
 	// Allocate an OS-appropriate IPC address:
 	//   Unix/macOS → Unix domain socket path (/tmp/ida-worker-{id}.sock)
 	//   Windows    → TCP loopback address (127.0.0.1:{free-port})
-	addr, err := allocateWorkerAddr(sess.ID)
+	addr, err := allocateWorkerAddr(id)
 	if err != nil {
-		return fmt.Errorf("failed to allocate worker address: %w", err)
+		return nil, fmt.Errorf("failed to allocate worker address: %w", err)
 	}
-	sess.SocketPath = addr
 
 	// Remove any leftover socket file from a previous run (no-op on Windows)
 	cleanupWorkerAddr(addr)
@@ -89,7 +248,15 @@ func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath s
 	workerCtx, cancel := context.WithCancel(context.Background())
 
 	cmdArgs := append([]string{m.pythonScript}, workerArgs(addr)...)
-	cmdArgs = append(cmdArgs, "--binary", binaryPath, "--session-id", sess.ID)
+	if binaryPath != "" {
+		cmdArgs = append(cmdArgs, "--binary", binaryPath)
+		if databasePath != "" {
+			cmdArgs = append(cmdArgs, "--database", databasePath)
+		}
+	} else {
+		cmdArgs = append(cmdArgs, "--pool")
+	}
+	cmdArgs = append(cmdArgs, "--session-id", id)
 	cmd := exec.CommandContext(workerCtx, findPython(), cmdArgs...)
 
 	// Inherit the current environment and prepend the generated protobuf
@@ -97,22 +264,35 @@ func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath s
 	genDir := filepath.Join(filepath.Dir(m.pythonScript), "gen")
 	cmd.Env = prependPythonPath(os.Environ(), genDir)
 
-	// In tests, discard output to prevent "Test I/O incomplete" errors
-	if flag.Lookup("test.v") != nil {
-		cmd.Stdout = io.Discard
-		cmd.Stderr = io.Discard
+	// Capture output per session. Pool workers buffer their startup output
+	// until Start attaches them to a session.
+	var logs *logBuffer
+	if binaryPath != "" {
+		logs = m.sessionLog(id)
 	} else {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+		logs = newLogBuffer(id, LogConfig{})
+	}
+	stdout := &logWriter{stream: "stdout", target: logs}
+	stderr := &logWriter{stream: "stderr", target: logs}
+	// In tests, don't echo output to prevent "Test I/O incomplete" errors
+	if flag.Lookup("test.v") == nil {
+		stdout.echo = os.Stdout
+		stderr.echo = os.Stderr
 	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
 
 	if err := cmd.Start(); err != nil {
 		cancel()
-		return fmt.Errorf("failed to start worker: %w", err)
+		return nil, fmt.Errorf("failed to start worker: %w", err)
 	}
 
-	sess.WorkerPID = cmd.Process.Pid
-	m.logger.Printf("[Worker] Started PID %d for session %s (addr: %s)", sess.WorkerPID, sess.ID, addr)
+	m.logger.Printf("[Worker] Started PID %d for %s (addr: %s)", cmd.Process.Pid, id, addr)
+
+	guard, err := applyLimits(id, cmd.Process.Pid, limits)
+	if err != nil {
+		m.logger.Printf("[Worker] Limits for %s only partly enforced: %v", id, err)
+	}
 
 	// Wait for the worker to be ready to accept connections
 	if err := waitForWorker(addr, 10*time.Second); err != nil {
@@ -123,7 +303,10 @@ func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath s
 		if waitErr := cmd.Wait(); waitErr != nil && !errors.Is(waitErr, os.ErrProcessDone) {
 			m.logger.Printf("[Worker] Failed to wait for PID %d: %v", cmd.Process.Pid, waitErr)
 		}
-		return fmt.Errorf("worker not ready: %w", err)
+		if guard != nil {
+			guard.release()
+		}
+		return nil, fmt.Errorf("worker not ready: %w", err)
 	}
 
 	// Create Connect RPC clients routed through the IPC transport
@@ -136,9 +319,10 @@ func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath s
 	}
 
 	baseURL := "http://worker"
-	sessionClient := workerconnect.NewSessionControlClient(httpClient, baseURL)
-	analysisClient := workerconnect.NewAnalysisToolsClient(httpClient, baseURL)
-	healthClient := workerconnect.NewHealthcheckClient(httpClient, baseURL)
+	interceptors := connect.WithInterceptors(RequestIDInterceptor())
+	sessionClient := workerconnect.NewSessionControlClient(httpClient, baseURL, interceptors)
+	analysisClient := workerconnect.NewAnalysisToolsClient(httpClient, baseURL, interceptors)
+	healthClient := workerconnect.NewHealthcheckClient(httpClient, baseURL, interceptors)
 
 	worker := &WorkerClient{
 		SessionCtrl: &sessionClient,
@@ -147,38 +331,305 @@ func (m *Manager) Start(ctx context.Context, sess *session.Session, binaryPath s
 		cmd:         cmd,
 		cancel:      cancel,
 		ctx:         workerCtx,
-		session:     sess,
-		binaryPath:  binaryPath,
+		addr:        addr,
+		exited:      make(chan struct{}),
+		health:      &healthMonitor{},
+		limits:      limits,
+		guard:       guard,
+		startup:     time.Since(started),
+		logs:        logs,
+		stdout:      stdout,
+		stderr:      stderr,
+	}
+	m.recordStartup(worker.startup)
+
+	go m.monitorWorker(worker)
+
+	return worker, nil
+}
+
+func (m *Manager) monitorWorker(worker *WorkerClient) {
+	pid := worker.cmd.Process.Pid
+	err := worker.cmd.Wait()
+	worker.stdout.flush()
+	worker.stderr.flush()
+	close(worker.exited)
+
+	m.mu.RLock()
+	sessionID := worker.sessionID
+	m.mu.RUnlock()
+	if sessionID == "" {
+		// Idle pool worker, or one that died while being assigned
+		m.logger.Printf("[Worker] Pool worker PID %d exited", pid)
+		m.dropIdle(worker)
+		if worker.guard != nil {
+			worker.guard.release()
+		}
+		return
+	}
+
+	crashed := worker.ctx.Err() == nil
+	if err != nil && crashed {
+		m.logger.Printf("[Worker] Process %d exited with error for session %s: %v", pid, sessionID, err)
+		worker.logs.add("manager", fmt.Sprintf("worker PID %d exited: %v", pid, err))
+	} else {
+		m.logger.Printf("[Worker] Process %d exited for session %s", pid, sessionID)
+	}
+	var limitReason string
+	if crashed {
+		limitReason = m.exitLimitReason(worker, err)
+	}
+	if worker.guard != nil {
+		worker.guard.release()
 	}
 
 	m.mu.Lock()
-	m.sessions[sess.ID] = worker
+	if m.sessions[sessionID] == worker {
+		delete(m.sessions, sessionID)
+	} else {
+		// Already replaced or stopped; nothing to recover
+		crashed = false
+	}
+	var hook func(RecoveryEvent)
+	if crashed && limitReason != "" {
+		// Restarting would only hit the same limit again
+		crashed = false
+		state := m.recovery[sessionID]
+		if state == nil {
+			state = &recoveryState{}
+			m.recovery[sessionID] = state
+		}
+		state.total++
+		state.limitReason = limitReason
+		hook = m.onRecovery
+	}
+	m.mu.Unlock()
+
+	if limitReason != "" && hook != nil {
+		m.logger.Printf("[Worker] Session %s stopped by resource limits: %s", sessionID, limitReason)
+		hook(RecoveryEvent{SessionID: sessionID, Err: fmt.Errorf("%w: %s", ErrResourceLimit, limitReason)})
+	}
+
+	if crashed {
+		worker.cancel()
+		reason := "exited unexpectedly"
+		if err != nil {
+			reason = err.Error()
+		}
+		m.mu.RLock()
+		if worker.killReason != "" {
+			reason = worker.killReason
+		}
+		m.mu.RUnlock()
+		m.recoverWorker(sessionID, worker, reason)
+	}
+}
+
+// recoverWorker restarts a crashed worker with exponential backoff and reopens
+// the saved database under the same session ID. Repeated crashes within the
+// crash window open the circuit breaker and leave the session without a worker.
+func (m *Manager) recoverWorker(sessionID string, crashed *WorkerClient, reason string) {
+	m.mu.Lock()
+	state := m.recovery[sessionID]
+	if state == nil {
+		state = &recoveryState{}
+		m.recovery[sessionID] = state
+	}
 	m.mu.Unlock()
 
-	go m.monitorWorker(sess.ID, worker)
+	for attempt := 0; ; attempt++ {
+		m.mu.Lock()
+		if state.stopped {
+			m.mu.Unlock()
+			return
+		}
+		now := time.Now()
+		state.total++
+		state.crashes = append(state.crashes, now)
+		recent := state.crashes[:0]
+		for _, at := range state.crashes {
+			if now.Sub(at) <= m.crashWindow {
+				recent = append(recent, at)
+			}
+		}
+		state.crashes = recent
+		if len(state.crashes) > m.maxCrashes {
+			state.circuitOpen = true
+			state.recovering = false
+			hook := m.onRecovery
+			m.mu.Unlock()
+			m.logger.Printf("[Worker] Session %s crashed %d times within %s; not restarting", sessionID, len(recent), m.crashWindow)
+			if hook != nil {
+				hook(RecoveryEvent{SessionID: sessionID, CircuitOpen: true, Err: ErrCircuitOpen})
+			}
+			return
+		}
+		state.recovering = true
+		m.mu.Unlock()
+
+		backoff := m.restartBackoff << attempt
+		if backoff > maxRestartBackoff || backoff <= 0 {
+			backoff = maxRestartBackoff
+		}
+		m.logger.Printf("[Worker] Restarting session %s in %s (%s)", sessionID, backoff, reason)
+		time.Sleep(backoff)
+
+		err := m.restartWorker(sessionID, crashed, state)
+
+		event := RestartEvent{At: time.Now(), Reason: reason, Success: err == nil}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		m.mu.Lock()
+		state.restarts = append(state.restarts, event)
+		if err == nil {
+			state.recovering = false
+		}
+		stopped := state.stopped
+		hook := m.onRecovery
+		m.mu.Unlock()
+
+		if stopped {
+			return
+		}
+		if err == nil {
+			m.logger.Printf("[Worker] Session %s recovered (PID %d)", sessionID, crashed.session.WorkerPID)
+			if hook != nil {
+				hook(RecoveryEvent{SessionID: sessionID, Recovered: true})
+			}
+			return
+		}
+		m.logger.Printf("[Worker] Restart of session %s failed: %v", sessionID, err)
+		reason = err.Error()
+	}
+}
+
+// restartWorker starts a replacement worker and reopens the session's database.
+func (m *Manager) restartWorker(sessionID string, crashed *WorkerClient, state *recoveryState) error {
+	m.mu.RLock()
+	stopped := state.stopped
+	m.mu.RUnlock()
+	if stopped {
+		return errors.New("session stopped")
+	}
+
+	if err := m.Start(context.Background(), crashed.session, crashed.binaryPath); err != nil {
+		return err
+	}
+	worker, err := m.GetClient(sessionID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	stopped = state.stopped
+	m.mu.RUnlock()
+	if stopped {
+		// Closed while the replacement was starting
+		m.discardWorker(sessionID, worker)
+		return errors.New("session stopped")
+	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), reopenTimeout)
+	defer cancel()
+	resp, err := (*worker.SessionCtrl).OpenBinary(ctx, connect.NewRequest(&pb.OpenBinaryRequest{
+		BinaryPath:   crashed.binaryPath,
+		AutoAnalyze:  false,
+		DatabasePath: crashed.session.DatabasePath,
+	}))
+	if err == nil && !resp.Msg.Success {
+		err = errors.New(resp.Msg.Error)
+	}
+	if err != nil {
+		m.discardWorker(sessionID, worker)
+		return fmt.Errorf("reopen database: %w", err)
+	}
 	return nil
 }
 
-func (m *Manager) monitorWorker(sessionID string, worker *WorkerClient) {
-	err := worker.cmd.Wait()
-	if err != nil && worker.ctx.Err() == nil {
-		m.logger.Printf("[Worker] Process %d exited with error for session %s: %v", worker.session.WorkerPID, sessionID, err)
-	} else {
-		m.logger.Printf("[Worker] Process %d exited for session %s", worker.session.WorkerPID, sessionID)
+// discardWorker kills a worker that never became usable, without triggering recovery.
+func (m *Manager) discardWorker(sessionID string, worker *WorkerClient) {
+	m.mu.Lock()
+	if m.sessions[sessionID] == worker {
+		delete(m.sessions, sessionID)
 	}
+	m.mu.Unlock()
+	worker.cancel()
+	if worker.cmd.Process != nil {
+		if err := worker.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			m.logger.Printf("[Worker] Failed to kill PID %d: %v", worker.cmd.Process.Pid, err)
+		}
+	}
+}
 
+// Info reports the worker and crash-recovery state for a session.
+func (m *Manager) Info(sessionID string) Info {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var info Info
+	if worker, ok := m.sessions[sessionID]; ok {
+		info.Running = true
+		if worker.cmd.Process != nil {
+			info.PID = worker.cmd.Process.Pid
+		}
+		info.Pooled = worker.pooled
+		info.StartupMs = float64(worker.startup) / float64(time.Millisecond)
+		health := worker.health.snapshot()
+		info.Health = &health
+		if worker.guard != nil {
+			info.LimitEnforcement = worker.guard.mechanism
+		}
+	}
+	if state, ok := m.recovery[sessionID]; ok {
+		info.Crashes = state.total
+		info.Recovering = state.recovering
+		info.CircuitOpen = state.circuitOpen
+		info.Restarts = append([]RestartEvent(nil), state.restarts...)
+		info.LimitExceeded = state.limitReason
+	}
+	return info
+}
+
+// Kill terminates a session's worker that is stuck in a call it cannot
+// abandon. It is restarted from its last saved database like a crashed
+// worker, and counts toward the crash circuit breaker.
+func (m *Manager) Kill(sessionID, reason string) error {
 	m.mu.Lock()
-	delete(m.sessions, sessionID)
+	worker, ok := m.sessions[sessionID]
+	if ok && worker.killReason == "" {
+		worker.killReason = reason
+	}
 	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no worker for session %s", sessionID)
+	}
+	m.logger.Printf("[Worker] Killing session %s PID %d: %s", sessionID, worker.cmd.Process.Pid, reason)
+	worker.logs.add("manager", fmt.Sprintf("killing worker PID %d: %s", worker.cmd.Process.Pid, reason))
+	if err := worker.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	return nil
 }
 
 // Stop terminates the worker for a session
 func (m *Manager) Stop(sessionID string) error {
-	m.mu.RLock()
+	m.mu.Lock()
 	worker, ok := m.sessions[sessionID]
-	m.mu.RUnlock()
+	state, crashed := m.recovery[sessionID]
+	if crashed {
+		// Abort any pending restart and forget the crash history
+		state.stopped = true
+		delete(m.recovery, sessionID)
+	}
+	if ok {
+		delete(m.sessions, sessionID)
+	}
+	m.mu.Unlock()
+	defer m.dropLog(sessionID)
 	if !ok {
+		if crashed {
+			return nil
+		}
 		return fmt.Errorf("no worker for session %s", sessionID)
 	}
 
@@ -188,7 +639,8 @@ func (m *Manager) Stop(sessionID string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if worker.SessionCtrl != nil {
+	// A hung worker would only run out the timeout; go straight to kill
+	if worker.SessionCtrl != nil && !worker.health.hung() {
 		(*worker.SessionCtrl).CloseSession(ctx, connect.NewRequest(&pb.CloseSessionRequest{Save: true}))
 	}
 
@@ -201,15 +653,13 @@ func (m *Manager) Stop(sessionID string) error {
 		}
 	}
 
-	// Wait for the process to exit to prevent zombies
-	if waitErr := worker.cmd.Wait(); waitErr != nil && !errors.Is(waitErr, os.ErrProcessDone) {
-		m.logger.Printf("[Worker] Process %d wait error: %v", worker.cmd.Process.Pid, waitErr)
+	// monitorWorker reaps the process; wait for it so no zombie outlives Stop
+	select {
+	case <-worker.exited:
+	case <-time.After(5 * time.Second):
+		m.logger.Printf("[Worker] Process %d did not exit after kill", worker.cmd.Process.Pid)
 	}
 
-	m.mu.Lock()
-	delete(m.sessions, sessionID)
-	m.mu.Unlock()
-
 	if killErr != nil && !errors.Is(killErr, os.ErrProcessDone) {
 		return fmt.Errorf("failed to kill worker: %w", killErr)
 	}
@@ -238,10 +688,44 @@ func prependPythonPath(env []string, dir string) []string {
 // GetClient returns the Connect RPC clients for a session
 func (m *Manager) GetClient(sessionID string) (*WorkerClient, error) {
 	m.mu.RLock()
-	worker, ok := m.sessions[sessionID]
-	m.mu.RUnlock()
-	if !ok {
-		return nil, fmt.Errorf("no worker for session %s", sessionID)
+	defer m.mu.RUnlock()
+	if worker, ok := m.sessions[sessionID]; ok {
+		return worker, nil
 	}
-	return worker, nil
+	// Recovery state changes under m.mu while a worker crashes and restarts
+	state := m.recovery[sessionID]
+	switch {
+	case state != nil && state.limitReason != "":
+		return nil, fmt.Errorf("session %s: %w: %s", sessionID, ErrResourceLimit, state.limitReason)
+	case state != nil && state.circuitOpen:
+		return nil, fmt.Errorf("session %s: %w after %d crashes", sessionID, ErrCircuitOpen, state.total)
+	case state != nil && state.recovering:
+		return nil, fmt.Errorf("session %s: %w", sessionID, ErrWorkerRecovering)
+	}
+	return nil, fmt.Errorf("no worker for session %s", sessionID)
+}
+
+// sessionIDs lists the sessions that have a worker or are recovering one.
+func (m *Manager) sessionIDs() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]string, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	for id := range m.recovery {
+		if _, ok := m.sessions[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// dial opens a connection to the worker of a session.
+func (m *Manager) dial(sessionID string) (net.Conn, error) {
+	worker, err := m.GetClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return dialWorker(worker.addr)
 }
@@ -2,8 +2,12 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -42,3 +46,482 @@ func TestManagerWorkerHasIndependentLifecycle(t *testing.T) {
 		t.Fatalf("GetClient failed: %v", err)
 	}
 }
+
+func TestManagerRestartsCrashedWorker(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.restartBackoff = 10 * time.Millisecond
+
+	recovered := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { recovered <- ev })
+
+	sess := &session.Session{ID: "crash-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	oldPID := mgr.Info(sess.ID).PID
+	killProcess(t, oldPID)
+
+	select {
+	case ev := <-recovered:
+		if !ev.Recovered || ev.SessionID != sess.ID {
+			t.Fatalf("unexpected recovery event: %+v", ev)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatal("worker was not restarted")
+	}
+
+	info := mgr.Info(sess.ID)
+	if info.PID == oldPID || !processAlive(info.PID) {
+		t.Fatalf("expected a new live worker, old PID %d new PID %d", oldPID, info.PID)
+	}
+	if _, err := mgr.GetClient(sess.ID); err != nil {
+		t.Fatalf("GetClient after restart failed: %v", err)
+	}
+	if info.Crashes != 1 || len(info.Restarts) != 1 || !info.Restarts[0].Success {
+		t.Fatalf("unexpected restart history: %+v", info)
+	}
+}
+
+func TestManagerGetClientDuringRecovery(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.restartBackoff = 50 * time.Millisecond
+
+	recovered := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { recovered <- ev })
+
+	sess := &session.Session{ID: "recovering-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	// Callers keep asking for the client while recovery rewrites its state;
+	// the race detector flags any unguarded read
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	var sawRecovering bool
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if _, err := mgr.GetClient(sess.ID); errors.Is(err, ErrWorkerRecovering) {
+				sawRecovering = true
+			}
+		}
+	}()
+
+	killProcess(t, mgr.Info(sess.ID).PID)
+	select {
+	case ev := <-recovered:
+		if !ev.Recovered {
+			t.Fatalf("unexpected recovery event: %+v", ev)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatal("worker was not restarted")
+	}
+	close(stop)
+	<-done
+	if !sawRecovering {
+		t.Fatal("expected GetClient to report the recovering worker")
+	}
+	if _, err := mgr.GetClient(sess.ID); err != nil {
+		t.Fatalf("GetClient after restart failed: %v", err)
+	}
+}
+
+func TestManagerKillRestartsStuckWorker(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.restartBackoff = 10 * time.Millisecond
+
+	recovered := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { recovered <- ev })
+
+	sess := &session.Session{ID: "stuck-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	oldPID := mgr.Info(sess.ID).PID
+	if err := mgr.Kill(sess.ID, "get_decompiled_func exceeded its 1m0s deadline"); err != nil {
+		t.Fatalf("Kill failed: %v", err)
+	}
+
+	select {
+	case ev := <-recovered:
+		if !ev.Recovered || ev.SessionID != sess.ID {
+			t.Fatalf("unexpected recovery event: %+v", ev)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatal("killed worker was not restarted")
+	}
+
+	info := mgr.Info(sess.ID)
+	if info.PID == oldPID || !processAlive(info.PID) {
+		t.Fatalf("expected a new live worker, old PID %d new PID %d", oldPID, info.PID)
+	}
+	if len(info.Restarts) != 1 || info.Restarts[0].Reason != "get_decompiled_func exceeded its 1m0s deadline" {
+		t.Fatalf("expected the kill reason in the restart history: %+v", info.Restarts)
+	}
+	if err := mgr.Kill("missing", "test"); err == nil {
+		t.Fatal("expected Kill of an unknown session to fail")
+	}
+}
+
+func TestManagerCircuitBreakerStopsRestarts(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.restartBackoff = 10 * time.Millisecond
+	mgr.maxCrashes = 0
+
+	tripped := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { tripped <- ev })
+
+	sess := &session.Session{ID: "breaker-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	killProcess(t, mgr.Info(sess.ID).PID)
+
+	select {
+	case ev := <-tripped:
+		if !ev.CircuitOpen {
+			t.Fatalf("expected circuit breaker event, got %+v", ev)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatal("circuit breaker did not trip")
+	}
+
+	if _, err := mgr.GetClient(sess.ID); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if err := mgr.Stop(sess.ID); err != nil {
+		t.Fatalf("Stop after circuit breaker failed: %v", err)
+	}
+	if info := mgr.Info(sess.ID); info.CircuitOpen || info.Crashes != 0 {
+		t.Fatalf("crash history not cleared by Stop: %+v", info)
+	}
+}
+
+func killProcess(t *testing.T, pid int) {
+	t.Helper()
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		t.Fatalf("FindProcess(%d): %v", pid, err)
+	}
+	if err := proc.Kill(); err != nil {
+		t.Fatalf("kill %d: %v", pid, err)
+	}
+}
+
+func TestManagerCapturesWorkerLogs(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.maxCrashes = 0
+	logDir := t.TempDir()
+	mgr.SetLogConfig(LogConfig{Dir: logDir})
+
+	tripped := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { tripped <- ev })
+
+	sess := &session.Session{ID: "logs-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(mgr.Logs(sess.ID, LogQuery{})) < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("worker output not captured: %+v", mgr.Logs(sess.ID, LogQuery{}))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	errs := mgr.Logs(sess.ID, LogQuery{MinLevel: "error"})
+	if len(errs) != 2 || errs[1].Line != "Traceback (most recent call last):" || errs[1].Level != "ERROR" {
+		t.Fatalf("expected error line and its continuation, got %+v", errs)
+	}
+	if tail := mgr.Logs(sess.ID, LogQuery{Tail: 1}); len(tail) != 1 || tail[0].Stream != "stderr" {
+		t.Fatalf("unexpected tail: %+v", tail)
+	}
+	if since := mgr.Logs(sess.ID, LogQuery{Since: time.Now().Add(time.Hour)}); len(since) != 0 {
+		t.Fatalf("expected no lines from the future, got %+v", since)
+	}
+
+	killProcess(t, mgr.Info(sess.ID).PID)
+	select {
+	case <-tripped:
+	case <-time.After(15 * time.Second):
+		t.Fatal("crash was not handled")
+	}
+
+	// Output of the crashed worker survives until the session is stopped
+	logs := mgr.Logs(sess.ID, LogQuery{})
+	if len(logs) < 4 || logs[len(logs)-1].Stream != "manager" {
+		t.Fatalf("expected crashed worker output and exit line, got %+v", logs)
+	}
+	data, err := os.ReadFile(filepath.Join(logDir, sess.ID+".log"))
+	if err != nil || !strings.Contains(string(data), "Simulated failure") {
+		t.Fatalf("log file not written: %v %q", err, data)
+	}
+
+	if err := mgr.Stop(sess.ID); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if logs := mgr.Logs(sess.ID, LogQuery{}); logs != nil {
+		t.Fatalf("expected logs dropped after Stop, got %+v", logs)
+	}
+}
+
+func TestManagerTracksWorkerStatus(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.healthInterval = 20 * time.Millisecond
+
+	sess := &session.Session{ID: "status-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		health := mgr.Info(sess.ID).Health
+		if health != nil && !health.StatusAt.IsZero() && !health.LastPing.IsZero() {
+			if health.MemoryBytes != 8<<30 || health.PendingRequests != 1 || health.Hung {
+				t.Fatalf("unexpected health: %+v", health)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("no status or ping recorded: %+v", health)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestManagerFlagsHungWorker(t *testing.T) {
+	t.Setenv("FAKE_WORKER_HANG", "1")
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.healthInterval = 20 * time.Millisecond
+	mgr.pingTimeout = 20 * time.Millisecond
+
+	sess := &session.Session{ID: "hung-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		info := mgr.Info(sess.ID)
+		if info.Health != nil && info.Health.Hung {
+			if !info.Running || !processAlive(info.PID) {
+				t.Fatalf("hung worker should still be running: %+v", info)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("worker never flagged as hung: %+v", info.Health)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestManagerKillsWorkerOverMemoryLimit(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.healthInterval = 20 * time.Millisecond
+	mgr.restartBackoff = 10 * time.Millisecond
+	// The fake worker reports 8 GiB resident
+	mgr.SetLimits(Limits{MemoryBytes: 4 << 30})
+
+	sess := &session.Session{ID: "limit-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for mgr.Info(sess.ID).LimitExceeded == "" {
+		if time.Now().After(deadline) {
+			t.Fatalf("worker was not killed over its limit: %+v", mgr.Info(sess.ID))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if _, err := mgr.GetClient(sess.ID); !errors.Is(err, ErrResourceLimit) {
+		t.Fatalf("expected ErrResourceLimit, got %v", err)
+	}
+	if info := mgr.Info(sess.ID); info.Running || len(info.Restarts) != 0 {
+		t.Fatalf("worker killed by its limit should not be restarted: %+v", info)
+	}
+}
+
+func TestManagerRestartsWorkerCrashingNearMemoryLimit(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.healthInterval = 20 * time.Millisecond
+	mgr.restartBackoff = 10 * time.Millisecond
+	// The fake worker reports 8 GiB resident, just under the limit
+	mgr.SetLimits(Limits{MemoryBytes: 17 << 29})
+
+	recovered := make(chan RecoveryEvent, 1)
+	mgr.OnRecovery(func(ev RecoveryEvent) { recovered <- ev })
+
+	sess := &session.Session{ID: "near-limit-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for health := mgr.Info(sess.ID).Health; health == nil || health.MemoryBytes == 0; health = mgr.Info(sess.ID).Health {
+		if time.Now().After(deadline) {
+			t.Fatalf("no status recorded: %+v", health)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	killProcess(t, mgr.Info(sess.ID).PID)
+
+	select {
+	case ev := <-recovered:
+		if !ev.Recovered {
+			t.Fatalf("unexpected recovery event: %+v", ev)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatalf("crash near the limit was not restarted: %+v", mgr.Info(sess.ID))
+	}
+	if info := mgr.Info(sess.ID); info.LimitExceeded != "" {
+		t.Fatalf("crash without evidence reported as a limit kill: %q", info.LimitExceeded)
+	}
+}
+
+func TestManagerReportsWorkerOutOfMemoryExit(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	t.Setenv("FAKE_WORKER_OOM", "1")
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.healthInterval = 20 * time.Millisecond
+	mgr.restartBackoff = 10 * time.Millisecond
+	mgr.SetLimits(Limits{MemoryBytes: 16 << 30})
+
+	sess := &session.Session{ID: "oom-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for mgr.Info(sess.ID).LimitExceeded == "" {
+		if time.Now().After(deadline) {
+			t.Fatalf("out of memory exit not reported: %+v", mgr.Info(sess.ID))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if _, err := mgr.GetClient(sess.ID); !errors.Is(err, ErrResourceLimit) {
+		t.Fatalf("expected ErrResourceLimit, got %v", err)
+	}
+	if info := mgr.Info(sess.ID); len(info.Restarts) != 0 {
+		t.Fatalf("worker out of memory should not be restarted: %+v", info)
+	}
+}
+
+func TestManagerRefusesStartWhenHostMemoryLow(t *testing.T) {
+	if _, ok := availableMemory(); !ok {
+		t.Skip("available memory not reported on this platform")
+	}
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	mgr.SetAdmission(Admission{MinFreeBytes: 1 << 62})
+
+	sess := &session.Session{ID: "admission-session"}
+	err := mgr.Start(context.Background(), sess, "/bin/ls")
+	if !errors.Is(err, ErrInsufficientMemory) {
+		t.Fatalf("expected ErrInsufficientMemory, got %v", err)
+	}
+	if sess.WorkerPID != 0 {
+		t.Fatalf("no worker should have been started, got PID %d", sess.WorkerPID)
+	}
+}
+
+func TestManagerAssignsPooledWorkers(t *testing.T) {
+	scriptPath := writeFakeWorker(t)
+	mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+	t.Cleanup(mgr.Close)
+
+	waitIdle := func(want int) {
+		t.Helper()
+		deadline := time.Now().Add(15 * time.Second)
+		for mgr.PoolStats().Idle != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("pool never reached %d idle workers: %+v", want, mgr.PoolStats())
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+
+	mgr.SetPoolSize(1)
+	waitIdle(1)
+
+	sess := &session.Session{ID: "pooled-session"}
+	if err := mgr.Start(context.Background(), sess, "/bin/ls"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = mgr.Stop(sess.ID)
+	})
+
+	info := mgr.Info(sess.ID)
+	if !info.Pooled || !processAlive(info.PID) {
+		t.Fatalf("expected a live pooled worker: %+v", info)
+	}
+	stats := mgr.PoolStats()
+	if stats.Hits != 1 || stats.Misses != 0 || stats.Startups == 0 || stats.AvgStartupMs <= 0 {
+		t.Fatalf("unexpected pool stats: %+v", stats)
+	}
+	// Startup output from the pool follows the worker into the session
+	deadline := time.Now().Add(5 * time.Second)
+	for len(mgr.Logs(sess.ID, LogQuery{})) < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("pooled worker output not attached: %+v", mgr.Logs(sess.ID, LogQuery{}))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// The pool refills in the background
+	waitIdle(1)
+
+	mgr.Close()
+	if stats := mgr.PoolStats(); stats.Idle != 0 {
+		t.Fatalf("Close should drain the pool: %+v", stats)
+	}
+	if !processAlive(info.PID) {
+		t.Fatal("Close must not stop workers assigned to sessions")
+	}
+}
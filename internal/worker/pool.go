@@ -0,0 +1,256 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+)
+
+const (
+	poolRetryInterval = 30 * time.Second
+	bindTimeout       = 2 * time.Minute
+)
+
+// PoolStats describes the pre-warmed worker pool.
+type PoolStats struct {
+	Size     int    `json:"size"`
+	Idle     int    `json:"idle"`
+	Starting int    `json:"starting"`
+	Hits     uint64 `json:"hits"`
+	Misses   uint64 `json:"misses"`
+	// Startup timing covers every spawned worker, pooled or not.
+	Startups      int     `json:"startups"`
+	LastStartupMs float64 `json:"last_startup_ms"`
+	AvgStartupMs  float64 `json:"avg_startup_ms"`
+	LastError     string  `json:"last_error,omitempty"`
+}
+
+// workerPool holds idle workers that have imported idalib but have no binary
+// yet. Guarded by Manager.mu.
+type workerPool struct {
+	size     int
+	idle     []*WorkerClient
+	starting int
+	hits     uint64
+	misses   uint64
+	closed   bool
+	running  bool
+	refill   chan struct{}
+
+	startups     int
+	lastStartup  time.Duration
+	totalStartup time.Duration
+	lastError    string
+}
+
+// SetPoolSize sets how many idle workers are kept ready. Zero disables the
+// pool; surplus idle workers are stopped.
+func (m *Manager) SetPoolSize(size int) {
+	m.mu.Lock()
+	m.pool.size = size
+	var surplus []*WorkerClient
+	for len(m.pool.idle) > size {
+		last := len(m.pool.idle) - 1
+		surplus = append(surplus, m.pool.idle[last])
+		m.pool.idle = m.pool.idle[:last]
+	}
+	if !m.pool.running && size > 0 {
+		m.pool.running = true
+		m.pool.refill = make(chan struct{}, 1)
+		go m.runPool()
+	}
+	m.mu.Unlock()
+
+	for _, worker := range surplus {
+		m.killIdle(worker)
+	}
+	m.triggerRefill()
+}
+
+// PoolStats reports pool size, hit rate and worker startup timing.
+func (m *Manager) PoolStats() PoolStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	stats := PoolStats{
+		Size:      m.pool.size,
+		Idle:      len(m.pool.idle),
+		Starting:  m.pool.starting,
+		Hits:      m.pool.hits,
+		Misses:    m.pool.misses,
+		Startups:  m.pool.startups,
+		LastError: m.pool.lastError,
+	}
+	if m.pool.startups > 0 {
+		stats.LastStartupMs = float64(m.pool.lastStartup) / float64(time.Millisecond)
+		stats.AvgStartupMs = float64(m.pool.totalStartup) / float64(m.pool.startups) / float64(time.Millisecond)
+	}
+	return stats
+}
+
+// Close stops the pool and its idle workers. Session workers are left to Stop.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	m.pool.closed = true
+	idle := m.pool.idle
+	m.pool.idle = nil
+	m.mu.Unlock()
+
+	for _, worker := range idle {
+		m.killIdle(worker)
+	}
+	m.triggerRefill()
+}
+
+func (m *Manager) recordStartup(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pool.startups++
+	m.pool.lastStartup = d
+	m.pool.totalStartup += d
+}
+
+// takeIdle assigns an idle pool worker to the session by opening the binary
+// in it. It returns nil when the pool is disabled, empty, or the idle worker
+// turned out to be unusable.
+func (m *Manager) takeIdle(ctx context.Context, sessionID, binaryPath, databasePath string) *WorkerClient {
+	m.mu.Lock()
+	if m.pool.size == 0 {
+		m.mu.Unlock()
+		return nil
+	}
+	if len(m.pool.idle) == 0 {
+		m.pool.misses++
+		m.mu.Unlock()
+		m.triggerRefill()
+		return nil
+	}
+	worker := m.pool.idle[0]
+	m.pool.idle = m.pool.idle[1:]
+	m.mu.Unlock()
+	m.triggerRefill()
+
+	bindCtx, cancel := context.WithTimeout(ctx, bindTimeout)
+	defer cancel()
+	_, err := (*worker.SessionCtrl).OpenBinary(bindCtx, connect.NewRequest(&pb.OpenBinaryRequest{
+		BinaryPath:   binaryPath,
+		AutoAnalyze:  false,
+		DatabasePath: databasePath,
+	}))
+	m.mu.Lock()
+	if err != nil {
+		m.pool.misses++
+		m.pool.lastError = fmt.Sprintf("assign to session %s: %v", sessionID, err)
+	} else {
+		m.pool.hits++
+		worker.pooled = true
+	}
+	m.mu.Unlock()
+	if err != nil {
+		m.logger.Printf("[Pool] Idle worker PID %d unusable for session %s: %v", worker.cmd.Process.Pid, sessionID, err)
+		m.killIdle(worker)
+		return nil
+	}
+	m.logger.Printf("[Pool] Assigned worker PID %d to session %s", worker.cmd.Process.Pid, sessionID)
+	return worker
+}
+
+// dropIdle forgets an idle worker whose process exited.
+func (m *Manager) dropIdle(worker *WorkerClient) {
+	m.mu.Lock()
+	for i, idle := range m.pool.idle {
+		if idle == worker {
+			m.pool.idle = append(m.pool.idle[:i], m.pool.idle[i+1:]...)
+			break
+		}
+	}
+	m.mu.Unlock()
+	m.triggerRefill()
+}
+
+func (m *Manager) killIdle(worker *WorkerClient) {
+	worker.cancel()
+	if err := worker.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		m.logger.Printf("[Pool] Failed to kill PID %d: %v", worker.cmd.Process.Pid, err)
+	}
+}
+
+func (m *Manager) triggerRefill() {
+	m.mu.RLock()
+	refill := m.pool.refill
+	m.mu.RUnlock()
+	if refill == nil {
+		return
+	}
+	select {
+	case refill <- struct{}{}:
+	default:
+	}
+}
+
+// runPool keeps the pool topped up until Close.
+func (m *Manager) runPool() {
+	m.mu.RLock()
+	refill := m.pool.refill
+	m.mu.RUnlock()
+	for {
+		select {
+		case <-refill:
+		case <-time.After(poolRetryInterval):
+		}
+		if !m.fillPool() {
+			return
+		}
+	}
+}
+
+// fillPool starts workers one at a time until the pool is full. It returns
+// false once the pool has been closed.
+func (m *Manager) fillPool() bool {
+	for {
+		m.mu.Lock()
+		if m.pool.closed {
+			m.mu.Unlock()
+			return false
+		}
+		if len(m.pool.idle)+m.pool.starting >= m.pool.size {
+			m.mu.Unlock()
+			return true
+		}
+		m.pool.starting++
+		limits := m.limits
+		minFree := m.admission.MinFreeBytes
+		m.mu.Unlock()
+
+		var worker *WorkerClient
+		var err error
+		if available, ok := availableMemory(); ok && minFree > 0 && available < minFree {
+			err = fmt.Errorf("%w: %d MiB available, %d MiB required", ErrInsufficientMemory, available>>20, minFree>>20)
+		} else {
+			worker, err = m.spawn("pool-"+uuid.NewString(), "", "", limits)
+		}
+
+		m.mu.Lock()
+		m.pool.starting--
+		if err != nil {
+			m.pool.lastError = err.Error()
+			m.mu.Unlock()
+			m.logger.Printf("[Pool] Failed to start idle worker: %v", err)
+			// Retry on the next trigger or tick rather than spinning
+			return true
+		}
+		keep := !m.pool.closed && len(m.pool.idle) < m.pool.size
+		if keep {
+			m.pool.idle = append(m.pool.idle, worker)
+		}
+		m.mu.Unlock()
+		if !keep {
+			m.killIdle(worker)
+		}
+	}
+}
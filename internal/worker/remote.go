@@ -0,0 +1,362 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/zboralski/ida-headless-mcp/ida/worker/v1/workerconnect"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+// ErrNoWorkerHost is returned when no remote host can take another session.
+var ErrNoWorkerHost = errors.New("no worker host available")
+
+const (
+	agentQueryTimeout = 5 * time.Second
+	agentStopTimeout  = 30 * time.Second
+)
+
+// RemoteController runs workers on agent hosts over HTTPS with mutual TLS,
+// placing each session on the least loaded host. Crash recovery, limits and
+// the worker pool are handled by each agent's own Manager.
+type RemoteController struct {
+	hosts    []*remoteHost
+	client   *http.Client
+	logger   *log.Logger
+	mu       sync.RWMutex
+	sessions map[string]*remoteSession
+}
+
+type remoteHost struct {
+	addr    string
+	pending int // starts in flight, guarded by RemoteController.mu
+}
+
+type remoteSession struct {
+	host   *remoteHost
+	client *WorkerClient
+}
+
+// NewRemoteController creates a controller for agents at hosts (host:port).
+func NewRemoteController(hosts []string, tlsConfig *tls.Config, logger *log.Logger) *RemoteController {
+	r := &RemoteController{
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig:   tlsConfig,
+				ForceAttemptHTTP2: true,
+			},
+		},
+		logger:   logger,
+		sessions: make(map[string]*remoteSession),
+	}
+	for _, addr := range hosts {
+		r.hosts = append(r.hosts, &remoteHost{addr: addr})
+	}
+	return r
+}
+
+// Start places the session on the least loaded host that accepts it.
+func (r *RemoteController) Start(ctx context.Context, sess *session.Session, binaryPath string) error {
+	candidates := r.place(ctx)
+	if len(candidates) == 0 {
+		return fmt.Errorf("session %s: %w", sess.ID, ErrNoWorkerHost)
+	}
+
+	var lastErr error
+	for _, host := range candidates {
+		r.mu.Lock()
+		host.pending++
+		r.mu.Unlock()
+		var resp agentStartResponse
+		err := r.call(ctx, host, http.MethodPost, "/agent/v1/sessions",
+			agentStartRequest{SessionID: sess.ID, BinaryPath: binaryPath}, &resp)
+		r.mu.Lock()
+		host.pending--
+		r.mu.Unlock()
+
+		if err != nil {
+			lastErr = err
+			var agentErr *agentError
+			if errors.As(err, &agentErr) && agentErr.Code != codeCapacity && agentErr.Code != codeInsufficientMemory {
+				// The worker itself failed; another host would fail the same way
+				return err
+			}
+			r.logger.Printf("[Remote] Host %s declined session %s: %v", host.addr, sess.ID, err)
+			continue
+		}
+
+		baseURL := "https://" + host.addr + "/workers/" + url.PathEscape(sess.ID)
+		interceptors := connect.WithInterceptors(RequestIDInterceptor())
+		sessionClient := workerconnect.NewSessionControlClient(r.client, baseURL, interceptors)
+		analysisClient := workerconnect.NewAnalysisToolsClient(r.client, baseURL, interceptors)
+		healthClient := workerconnect.NewHealthcheckClient(r.client, baseURL, interceptors)
+
+		sess.SocketPath = baseURL
+		sess.WorkerPID = resp.PID
+
+		r.mu.Lock()
+		r.sessions[sess.ID] = &remoteSession{
+			host: host,
+			client: &WorkerClient{
+				SessionCtrl: &sessionClient,
+				Analysis:    &analysisClient,
+				Health:      &healthClient,
+				sessionID:   sess.ID,
+				session:     sess,
+				binaryPath:  binaryPath,
+			},
+		}
+		r.mu.Unlock()
+		r.logger.Printf("[Remote] Session %s placed on %s (PID %d)", sess.ID, host.addr, resp.PID)
+		return nil
+	}
+	return fmt.Errorf("session %s: %w: %w", sess.ID, ErrNoWorkerHost, lastErr)
+}
+
+// place returns the reachable hosts with spare capacity, least loaded first.
+func (r *RemoteController) place(ctx context.Context) []*remoteHost {
+	loads := r.loads(ctx)
+
+	type candidate struct {
+		host *remoteHost
+		load HostLoad
+		used float64
+	}
+	var candidates []candidate
+	r.mu.RLock()
+	for i, host := range r.hosts {
+		load, ok := loads[i]
+		if !ok {
+			continue
+		}
+		sessions := load.Sessions + host.pending
+		if load.Capacity > 0 && sessions >= load.Capacity {
+			continue
+		}
+		c := candidate{host: host, load: load}
+		if load.Capacity > 0 {
+			c.used = float64(sessions) / float64(load.Capacity)
+		}
+		c.load.Sessions = sessions
+		candidates = append(candidates, c)
+	}
+	r.mu.RUnlock()
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.used != b.used {
+			return a.used < b.used
+		}
+		if a.load.Sessions != b.load.Sessions {
+			return a.load.Sessions < b.load.Sessions
+		}
+		return a.load.AvailableMemoryBytes > b.load.AvailableMemoryBytes
+	})
+	hosts := make([]*remoteHost, len(candidates))
+	for i, c := range candidates {
+		hosts[i] = c.host
+	}
+	return hosts
+}
+
+// loads queries every host concurrently, keyed by host index. Unreachable
+// hosts are left out.
+func (r *RemoteController) loads(ctx context.Context) map[int]HostLoad {
+	ctx, cancel := context.WithTimeout(ctx, agentQueryTimeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	loads := make(map[int]HostLoad, len(r.hosts))
+	for i, host := range r.hosts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var load HostLoad
+			if err := r.call(ctx, host, http.MethodGet, "/agent/v1/load", nil, &load); err != nil {
+				r.logger.Printf("[Remote] Host %s unavailable: %v", host.addr, err)
+				return
+			}
+			load.Host = host.addr
+			mu.Lock()
+			loads[i] = load
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return loads
+}
+
+// Stop terminates the session's worker on its host.
+func (r *RemoteController) Stop(sessionID string) error {
+	r.mu.Lock()
+	rs, ok := r.sessions[sessionID]
+	delete(r.sessions, sessionID)
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no worker for session %s", sessionID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), agentStopTimeout)
+	defer cancel()
+	return r.call(ctx, rs.host, http.MethodDelete, "/agent/v1/sessions/"+url.PathEscape(sessionID), nil, nil)
+}
+
+// Kill terminates a stuck worker; its agent restarts it from the last save.
+func (r *RemoteController) Kill(sessionID, reason string) error {
+	rs := r.session(sessionID)
+	if rs == nil {
+		return fmt.Errorf("no worker for session %s", sessionID)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), agentStopTimeout)
+	defer cancel()
+	return r.call(ctx, rs.host, http.MethodPost, "/agent/v1/sessions/"+url.PathEscape(sessionID)+"/kill", agentKillRequest{Reason: reason}, nil)
+}
+
+// GetClient returns Connect clients routed through the session's agent.
+func (r *RemoteController) GetClient(sessionID string) (*WorkerClient, error) {
+	r.mu.RLock()
+	rs, ok := r.sessions[sessionID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no worker for session %s", sessionID)
+	}
+	return rs.client, nil
+}
+
+// CleanupOrphanSockets is a no-op; each agent cleans up its own host.
+func (r *RemoteController) CleanupOrphanSockets() int { return 0 }
+
+// CleanupOrphanProcesses is a no-op; each agent cleans up its own host.
+func (r *RemoteController) CleanupOrphanProcesses() int { return 0 }
+
+// LocalFilesystem is false: databases live on the worker hosts.
+func (r *RemoteController) LocalFilesystem() bool { return false }
+
+// Info reports the worker state from the session's agent.
+func (r *RemoteController) Info(sessionID string) Info {
+	rs := r.session(sessionID)
+	if rs == nil {
+		return Info{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), agentQueryTimeout)
+	defer cancel()
+	var info Info
+	if err := r.call(ctx, rs.host, http.MethodGet, "/agent/v1/sessions/"+url.PathEscape(sessionID), nil, &info); err != nil {
+		r.logger.Printf("[Remote] Info for session %s on %s failed: %v", sessionID, rs.host.addr, err)
+	}
+	info.Host = rs.host.addr
+	return info
+}
+
+// PoolStats sums the pool statistics of every reachable host.
+func (r *RemoteController) PoolStats() PoolStats {
+	var total PoolStats
+	var totalStartupMs float64
+	for _, load := range r.loads(context.Background()) {
+		pool := load.Pool
+		total.Size += pool.Size
+		total.Idle += pool.Idle
+		total.Starting += pool.Starting
+		total.Hits += pool.Hits
+		total.Misses += pool.Misses
+		total.Startups += pool.Startups
+		totalStartupMs += pool.AvgStartupMs * float64(pool.Startups)
+		total.LastStartupMs = max(total.LastStartupMs, pool.LastStartupMs)
+		if total.LastError == "" && pool.LastError != "" {
+			total.LastError = load.Host + ": " + pool.LastError
+		}
+	}
+	if total.Startups > 0 {
+		total.AvgStartupMs = totalStartupMs / float64(total.Startups)
+	}
+	return total
+}
+
+// Logs fetches captured worker output from the session's agent.
+func (r *RemoteController) Logs(sessionID string, query LogQuery) []LogEntry {
+	rs := r.session(sessionID)
+	if rs == nil {
+		return nil
+	}
+	params := url.Values{}
+	if query.Tail > 0 {
+		params.Set("tail", strconv.Itoa(query.Tail))
+	}
+	if query.MinLevel != "" {
+		params.Set("level", query.MinLevel)
+	}
+	if !query.Since.IsZero() {
+		params.Set("since", query.Since.Format(time.RFC3339Nano))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), agentQueryTimeout)
+	defer cancel()
+	var entries []LogEntry
+	path := "/agent/v1/sessions/" + url.PathEscape(sessionID) + "/logs?" + params.Encode()
+	if err := r.call(ctx, rs.host, http.MethodGet, path, nil, &entries); err != nil {
+		r.logger.Printf("[Remote] Logs for session %s on %s failed: %v", sessionID, rs.host.addr, err)
+		return nil
+	}
+	return entries
+}
+
+// Close releases idle connections to the agents.
+func (r *RemoteController) Close() {
+	r.client.CloseIdleConnections()
+}
+
+func (r *RemoteController) session(sessionID string) *remoteSession {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sessions[sessionID]
+}
+
+// call sends a JSON request to an agent and decodes the JSON response into
+// out. Agent failures come back as *agentError.
+func (r *RemoteController) call(ctx context.Context, host *remoteHost, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, "https://"+host.addr+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("agent %s: %w", host.addr, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		agentErr := &agentError{}
+		if json.NewDecoder(resp.Body).Decode(agentErr) != nil || agentErr.Message == "" {
+			agentErr.Message = fmt.Sprintf("agent %s: %s", host.addr, resp.Status)
+		}
+		return agentErr
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
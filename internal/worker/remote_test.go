@@ -0,0 +1,201 @@
+package worker
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pb "github.com/zboralski/ida-headless-mcp/ida/worker/v1"
+	"github.com/zboralski/ida-headless-mcp/internal/session"
+)
+
+func TestRemoteControllerPlacesSessionsOnAgents(t *testing.T) {
+	certs := writeTestCerts(t)
+	serverTLS, err := LoadTLSConfig(certs.agentCert, certs.agentKey, certs.ca, true)
+	if err != nil {
+		t.Fatalf("agent TLS: %v", err)
+	}
+	clientTLS, err := LoadTLSConfig(certs.clientCert, certs.clientKey, certs.ca, false)
+	if err != nil {
+		t.Fatalf("client TLS: %v", err)
+	}
+
+	scriptPath := writeFakeWorker(t)
+	var hosts []string
+	var managers []*Manager
+	for range 2 {
+		mgr := NewManager(scriptPath, log.New(io.Discard, "", 0))
+		agent := NewAgent(mgr, 1, log.New(io.Discard, "", 0))
+		srv := httptest.NewUnstartedServer(agent.Handler())
+		srv.TLS = serverTLS.Clone()
+		srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		t.Cleanup(agent.Shutdown)
+		hosts = append(hosts, strings.TrimPrefix(srv.URL, "https://"))
+		managers = append(managers, mgr)
+	}
+
+	remote := NewRemoteController(hosts, clientTLS, log.New(io.Discard, "", 0))
+	t.Cleanup(remote.Close)
+	ctx := context.Background()
+
+	first := &session.Session{ID: "remote-a"}
+	second := &session.Session{ID: "remote-b"}
+	for _, sess := range []*session.Session{first, second} {
+		if err := remote.Start(ctx, sess, "/bin/ls"); err != nil {
+			t.Fatalf("Start %s: %v", sess.ID, err)
+		}
+	}
+	hostA, hostB := remote.Info(first.ID).Host, remote.Info(second.ID).Host
+	if hostA == hostB {
+		t.Fatalf("expected sessions on different hosts, both on %s", hostA)
+	}
+	if info := remote.Info(first.ID); !info.Running || info.PID != first.WorkerPID {
+		t.Fatalf("unexpected remote info: %+v (session PID %d)", info, first.WorkerPID)
+	}
+
+	// Both agents are at capacity
+	err = remote.Start(ctx, &session.Session{ID: "remote-c"}, "/bin/ls")
+	if !errors.Is(err, ErrNoWorkerHost) {
+		t.Fatalf("expected ErrNoWorkerHost, got %v", err)
+	}
+
+	// Connect services are proxied through the agent
+	client, err := remote.GetClient(first.ID)
+	if err != nil {
+		t.Fatalf("GetClient: %v", err)
+	}
+	if _, err := (*client.Health).Ping(ctx, connect.NewRequest(&pb.PingRequest{})); err != nil {
+		t.Fatalf("Ping through agent: %v", err)
+	}
+	if _, err := (*client.SessionCtrl).OpenBinary(ctx, connect.NewRequest(&pb.OpenBinaryRequest{BinaryPath: "/bin/ls"})); err != nil {
+		t.Fatalf("OpenBinary through agent: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(remote.Logs(first.ID, LogQuery{MinLevel: "error"})) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("remote logs not available: %+v", remote.Logs(first.ID, LogQuery{}))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if err := remote.Stop(first.ID); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	for _, mgr := range managers {
+		if _, err := mgr.GetClient(first.ID); err == nil {
+			t.Fatal("agent still runs the stopped session")
+		}
+	}
+	// The freed host takes the next session
+	if err := remote.Start(ctx, &session.Session{ID: "remote-c"}, "/bin/ls"); err != nil {
+		t.Fatalf("Start after Stop: %v", err)
+	}
+	if host := remote.Info("remote-c").Host; host != hostA {
+		t.Fatalf("expected remote-c on %s, got %s", hostA, host)
+	}
+
+	// Agents refuse clients without a certificate signed by the CA
+	anonymous := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: clientTLS.RootCAs}}}
+	if resp, err := anonymous.Get("https://" + hosts[0] + "/agent/v1/load"); err == nil {
+		resp.Body.Close()
+		t.Fatal("agent accepted a client without a certificate")
+	}
+}
+
+type testCerts struct {
+	ca                    string
+	agentCert, agentKey   string
+	clientCert, clientKey string
+}
+
+// writeTestCerts writes a CA plus agent and client certificates signed by it.
+func writeTestCerts(t *testing.T) testCerts {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		certPath := filepath.Join(dir, name+".crt")
+		keyPath := filepath.Join(dir, name+".key")
+		writePEM(t, certPath, "CERTIFICATE", der)
+		writePEM(t, keyPath, "EC PRIVATE KEY", keyDER)
+		return certPath, keyPath
+	}
+
+	certs := testCerts{ca: filepath.Join(dir, "ca.crt")}
+	writePEM(t, certs.ca, "CERTIFICATE", caDER)
+	certs.agentCert, certs.agentKey = issue("agent", 2, x509.ExtKeyUsageServerAuth)
+	certs.clientCert, certs.clientKey = issue("client", 3, x509.ExtKeyUsageClientAuth)
+	return certs
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+// RequestIDHeader carries the ID SessionControl.Cancel names a call by.
+const RequestIDHeader = "Ida-Request-Id"
+
+type requestIDKey struct{}
+
+// WithRequestID tags the worker calls made with ctx with id, so they can be
+// cancelled.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the ID WithRequestID attached to ctx, or "".
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestIDInterceptor sends the request ID of each call's context to the
+// worker.
+func RequestIDInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if id := RequestID(ctx); id != "" {
+				req.Header().Set(RequestIDHeader, id)
+			}
+			return next(ctx, req)
+		}
+	})
+}
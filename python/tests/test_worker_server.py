@@ -0,0 +1,167 @@
+#!/usr/bin/env python3
+"""
+Test the worker's HTTP/1.1 connection handling and main-thread executor.
+
+server.py is imported with idalib, the Connect service and the IDA wrapper
+stubbed out, so these tests run without IDA Pro or protobuf installed.
+"""
+import socket
+import sys
+import threading
+import time
+import types
+from pathlib import Path
+
+WORKER_DIR = Path(__file__).parent.parent / "worker"
+sys.path.insert(0, str(WORKER_DIR))
+
+for _name, _attrs in [
+    ("ida", {}),
+    ("connect_server", {"ConnectServer": object}),
+    ("ida_wrapper", {"IDAWrapper": object}),
+]:
+    if _name not in sys.modules:
+        _stub = types.ModuleType(_name)
+        _stub.__dict__.update(_attrs)
+        sys.modules[_name] = _stub
+
+import server  # noqa: E402
+
+
+def serve_one(handler):
+    """Run handle_connection on one end of a socket pair; return the other."""
+    client, conn = socket.socketpair()
+    thread = threading.Thread(target=server.handle_connection, args=(conn, handler), daemon=True)
+    thread.start()
+    return client, thread
+
+
+def read_all(sock):
+    data = b""
+    while chunk := sock.recv(4096):
+        data += chunk
+    return data
+
+
+def test_chunked_request_body():
+    received = {}
+
+    def handler(method, path, request_data):
+        received["request"] = request_data
+        return b"HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"
+
+    client, thread = serve_one(handler)
+    client.sendall(b"POST /svc/Method HTTP/1.1\r\nTransfer-Encoding: chunked\r\n\r\n")
+    # The first chunk's data ends like a terminating chunk would
+    client.sendall(b"5\r\nab0\r\n\r\n")
+    time.sleep(0.1)
+    client.sendall(b"3;ext=1\r\nxyz\r")
+    time.sleep(0.1)
+    client.sendall(b"\n0\r\nTrailer: x\r\n\r\n")
+    read_all(client)
+    thread.join(timeout=5)
+    client.close()
+
+    head, _, body = received["request"].partition(b"\r\n\r\n")
+    assert head.startswith(b"POST /svc/Method")
+    assert body == b"ab0\r\nxyz"
+
+
+def test_truncated_chunked_body_is_not_dispatched():
+    calls = []
+    client, thread = serve_one(lambda *args: calls.append(args) or b"")
+    client.sendall(b"POST /svc/Method HTTP/1.1\r\nTransfer-Encoding: chunked\r\n\r\n5\r\nab")
+    client.shutdown(socket.SHUT_WR)
+    thread.join(timeout=5)
+    client.close()
+    assert not thread.is_alive()
+    assert calls == []
+
+
+def test_content_length_body_across_reads():
+    received = {}
+
+    def handler(method, path, request_data):
+        received["request"] = request_data
+        return b"HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"
+
+    client, thread = serve_one(handler)
+    client.sendall(b"POST /svc/Method HTTP/1.1\r\nContent-Length: 10\r\n\r\n0123")
+    time.sleep(0.1)
+    client.sendall(b"45")
+    time.sleep(0.1)
+    client.sendall(b"6789")
+    read_all(client)
+    thread.join(timeout=5)
+    client.close()
+    assert received["request"].endswith(b"\r\n\r\n0123456789")
+
+
+def test_streamed_response():
+    def handler(method, path, request_data):
+        yield b"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n"
+        for message in (b"one", b"two", b"three"):
+            yield b"%x\r\n%s\r\n" % (len(message), message)
+        yield b"0\r\n\r\n"
+
+    client, thread = serve_one(handler)
+    client.sendall(b"POST /svc/Stream HTTP/1.1\r\nContent-Length: 0\r\n\r\n")
+    response = read_all(client)
+    thread.join(timeout=5)
+    client.close()
+    assert response == (
+        b"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n"
+        b"3\r\none\r\n3\r\ntwo\r\n5\r\nthree\r\n0\r\n\r\n"
+    )
+
+
+def test_streamed_response_stops_when_client_leaves():
+    stopped = threading.Event()
+
+    def handler(method, path, request_data):
+        try:
+            yield b"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n"
+            while True:
+                yield b"4\r\nping\r\n"
+                time.sleep(0.01)
+        finally:
+            stopped.set()
+
+    client, thread = serve_one(handler)
+    client.sendall(b"POST /svc/Stream HTTP/1.1\r\nContent-Length: 0\r\n\r\n")
+    assert client.recv(4096).startswith(b"HTTP/1.1 200 OK")
+    client.close()
+    thread.join(timeout=5)
+    assert not thread.is_alive()
+    assert stopped.is_set()
+
+
+def test_executor_runs_on_main_thread_and_propagates_errors():
+    executor = server.MainThreadExecutor()
+    outcome = {}
+
+    def fail():
+        raise ValueError("bad address")
+
+    def caller():
+        outcome["thread"] = executor.run(threading.current_thread)
+        try:
+            executor.run(fail)
+        except ValueError as e:
+            outcome["error"] = e
+        outcome["result"] = executor.run(lambda: 42)
+
+    thread = threading.Thread(target=caller)
+    thread.start()
+    executor.serve_forever(thread)
+
+    assert outcome["thread"] is threading.main_thread()
+    assert str(outcome["error"]) == "bad address"
+    assert outcome["result"] == 42
+
+
+if __name__ == "__main__":
+    for name, test in list(globals().items()):
+        if name.startswith("test_") and callable(test):
+            test()
+            print(f"{name}: ok")
@@ -5,7 +5,10 @@ Implements SessionControl, AnalysisTools, and Healthcheck services
 
 import json
 import logging
+import os
+import struct
 import sys
+import threading
 import time
 from pathlib import Path
 
@@ -16,12 +19,45 @@ from ida.worker.v1 import service_pb2 as pb
 from errors import IDAError, ErrorKind
 
 
+# Header naming a call for SessionControl.Cancel
+REQUEST_ID_HEADER = b"ida-request-id"
+
+# AnalysisTools methods that modify the database
+MUTATING_METHODS = frozenset({
+    "MakeFunction",
+    "ImportIl2Cpp",
+    "ImportFlutter",
+    "SetGlobalType",
+    "RenameGlobal",
+    "SetComment",
+    "SetFuncComment",
+    "SetLvarType",
+    "RenameLvar",
+    "SetDecompilerComment",
+    "SetName",
+    "DeleteName",
+    "SetFunctionType",
+})
+
+
 class ConnectServer:
     """Simple Connect RPC handler over HTTP"""
 
-    def __init__(self, ida_wrapper):
+    def __init__(self, ida_wrapper, run_ida=None):
         self.ida = ida_wrapper
         self.pending_requests = 0
+        # run_ida executes a callable wherever idalib may be used (the main
+        # thread in server.py). Healthcheck bypasses it, so a busy worker
+        # still answers Ping.
+        self._run_ida = run_ida or (lambda fn: fn())
+        self._pending_lock = threading.Lock()
+        # Request IDs of calls waiting for and holding the main thread, and
+        # of waiting calls cancelled before they started. Cancel also runs
+        # outside run_ida; it waits on _calls for the running call to stop.
+        self._calls = threading.Condition()
+        self._queued_ids: set[str] = set()
+        self._cancelled_ids: set[str] = set()
+        self._running_id: str | None = None
 
     def _ensure_database_open(self, auto_analyze: bool) -> tuple[bool, str | None]:
         """Ensure the IDA database is open before servicing requests."""
@@ -39,34 +75,109 @@ class ConnectServer:
         if not success:
             raise IDAError.database_closed(operation="require_open_database")
 
-    def handle(self, method: str, path: str, data: bytes) -> bytes:
-        """Handle Connect RPC request"""
-        try:
-            self.pending_requests += 1
-
-            # Parse service and method from path
-            # Path format: /idagrpc.v1.ServiceName/MethodName
-            parts = path.split("/")
-            if len(parts) < 3:
-                return self._connect_error_response("invalid_argument", "Invalid path")
-
-            service = parts[-2].split(".")[-1]  # Extract ServiceName
-            rpc_method = parts[-1]
+    def handle(self, method: str, path: str, data: bytes):
+        """Handle Connect RPC request.
 
-            # Extract protobuf body from HTTP request
-            proto_body = self._extract_body(data)
-
-            # Route to appropriate handler
+        Returns the full HTTP response as bytes, or an iterator of byte chunks
+        for server-streaming methods.
+        """
+        # Parse service and method from path
+        # Path format: /idagrpc.v1.ServiceName/MethodName
+        parts = path.split("/")
+        if len(parts) < 3:
+            return self._connect_error_response("invalid_argument", "Invalid path")
+
+        service = parts[-2].split(".")[-1]  # Extract ServiceName
+        rpc_method = parts[-1]
+
+        # Extract protobuf body from HTTP request
+        proto_body = self._extract_body(data)
+
+        if service == "Healthcheck":
+            if rpc_method == "StatusStream":
+                return self._stream_status(proto_body)
+            return self._dispatch(lambda: self._handle_healthcheck(rpc_method, proto_body))
+        if service == "SessionControl" and rpc_method == "Cancel":
+            return self._dispatch(lambda: self._cancel(proto_body))
+
+        request_id = self._request_id(data)
+        with self._pending_lock:
+            self.pending_requests += 1
+        if request_id:
+            with self._calls:
+                self._queued_ids.add(request_id)
+        try:
             if service == "SessionControl":
-                response_pb = self._handle_session_control(rpc_method, proto_body)
+                return self._run_ida(lambda: self._run_call(request_id, rpc_method, lambda: self._handle_session_control(rpc_method, proto_body)))
             elif service == "AnalysisTools":
-                response_pb = self._handle_analysis_tools(rpc_method, proto_body)
-            elif service == "Healthcheck":
-                response_pb = self._handle_healthcheck(rpc_method, proto_body)
+                return self._run_ida(lambda: self._run_call(request_id, rpc_method, lambda: self._handle_analysis_tools(rpc_method, proto_body)))
+            return self._connect_error_response("not_found", f"Unknown service: {service}")
+        finally:
+            with self._pending_lock:
+                self.pending_requests -= 1
+            if request_id:
+                with self._calls:
+                    self._queued_ids.discard(request_id)
+
+    def _run_call(self, request_id: str, rpc_method: str, call) -> bytes:
+        """Run a call on the IDA thread, unless it was cancelled while queued."""
+        with self._calls:
+            if request_id in self._cancelled_ids:
+                self._cancelled_ids.discard(request_id)
+                cancelled = True
             else:
-                return self._connect_error_response("not_found", f"Unknown service: {service}")
-
-            return self._success_response(response_pb)
+                cancelled = False
+                self._running_id = request_id or None
+                self.ida.cancel_event.clear()
+        if cancelled:
+            def skip():
+                raise IDAError.cancelled(operation=rpc_method)
+            return self._dispatch(skip)
+        try:
+            return self._dispatch(call)
+        finally:
+            with self._calls:
+                self._running_id = None
+                self.ida.cancel_event.clear()
+                self._calls.notify_all()
+
+    def _cancel(self, proto_body: bytes):
+        """Handle SessionControl.Cancel: stop the named call at its next safe
+        point, or before it starts if it is still queued."""
+        req = pb.CancelRequest()
+        req.ParseFromString(proto_body)
+        if not req.request_id:
+            raise IDAError.invalid_input("request_id is required", operation="cancel")
+        resp = pb.CancelResponse()
+        with self._calls:
+            if self._running_id == req.request_id:
+                resp.found = True
+                self.ida.cancel_event.set()
+                logging.info(f"Cancelling running call {req.request_id}")
+                resp.stopped = self._calls.wait_for(
+                    lambda: self._running_id != req.request_id,
+                    timeout=req.wait_ms / 1000,
+                )
+            elif req.request_id in self._queued_ids:
+                resp.found = True
+                resp.stopped = True
+                self._cancelled_ids.add(req.request_id)
+                logging.info(f"Cancelled queued call {req.request_id}")
+        return resp
+
+    def _request_id(self, data: bytes) -> str:
+        """Value of the Ida-Request-Id header, or "" when absent."""
+        head = data.split(b"\r\n\r\n", 1)[0]
+        for line in head.split(b"\r\n")[1:]:
+            name, sep, value = line.partition(b":")
+            if sep and name.strip().lower() == REQUEST_ID_HEADER:
+                return value.strip().decode("ascii", "replace")
+        return ""
+
+    def _dispatch(self, call) -> bytes:
+        """Run a unary handler and encode its result or error."""
+        try:
+            return self._success_response(call())
 
         except IDAError as e:
             logging.error(f"IDAError [{e.kind.value}] {e.operation}: {e.message}")
@@ -76,6 +187,7 @@ class ConnectServer:
                 ErrorKind.INVALID_INPUT: "invalid_argument",
                 ErrorKind.DECOMPILER_UNAVAILABLE: "unimplemented",
                 ErrorKind.API_INCOMPATIBLE: "unimplemented",
+                ErrorKind.CANCELLED: "canceled",
                 ErrorKind.INTERNAL: "internal",
             }
             connect_code = code_map.get(e.kind, "internal")
@@ -83,8 +195,6 @@ class ConnectServer:
         except Exception as e:
             logging.error(f"Unexpected error handling request: {e}", exc_info=True)
             return self._connect_error_response("internal", "Internal server error")
-        finally:
-            self.pending_requests -= 1
 
     def _handle_session_control(self, method: str, proto_body: bytes):
         """Handle SessionControl RPC - returns protobuf message"""
@@ -93,12 +203,22 @@ class ConnectServer:
             req.ParseFromString(proto_body)
             resp = pb.OpenBinaryResponse()
 
+            # Pool workers start without a binary and are assigned one here
+            if not self.ida.binary_path and req.binary_path:
+                self.ida.binary_path = req.binary_path
+                logging.info(f"Assigned binary: {req.binary_path}")
+            if not self.ida.database_path and req.database_path:
+                self.ida.database_path = req.database_path
+                logging.info(f"Database placed at: {req.database_path}")
+
             success, error = self._ensure_database_open(req.auto_analyze)
             resp.success = success
             resp.binary_path = self.ida.binary_path
 
             if success:
                 resp.has_decompiler = self.ida.has_decompiler
+                resp.processor = self.ida.processor
+                resp.bitness = self.ida.bitness
             else:
                 resp.error = error or "Failed to open IDA database"
             return resp
@@ -127,6 +247,7 @@ class ConnectServer:
 
         elif method == "PlanAndWait":
             self._require_open_database()
+            self.ida.mark_dirty()
             success, duration, error = self.ida.plan_and_wait()
             resp = pb.PlanAndWaitResponse()
             resp.success = success
@@ -151,6 +272,13 @@ class ConnectServer:
 
     def _handle_analysis_tools(self, method: str, proto_body: bytes):
         """Handle AnalysisTools RPC - returns protobuf message"""
+        resp = self._run_analysis_tool(method, proto_body)
+        # Only a change that was made leaves the database unsaved
+        if method in MUTATING_METHODS and getattr(resp, "success", True):
+            self.ida.mark_dirty()
+        return resp
+
+    def _run_analysis_tool(self, method: str, proto_body: bytes):
         try:
             self._require_open_database()
             if method == "GetBytes":
@@ -638,19 +766,41 @@ class ConnectServer:
             resp.alive = True
             return resp
 
-        elif method == "StatusStream":
-            # For now, return single status (streaming would need more work)
-            resp = pb.WorkerStatus()
-            resp.timestamp = int(time.time())
-            resp.memory_bytes = 0  # TODO: implement
-            resp.dirty = False
-            resp.last_activity = int(self.ida.last_activity)
-            resp.pending_requests = self.pending_requests
-            return resp
-
         else:
             raise IDAError.invalid_input(f"Unknown Healthcheck method: {method}", operation="healthcheck")
 
+    def _worker_status(self):
+        resp = pb.WorkerStatus()
+        resp.timestamp = int(time.time())
+        resp.memory_bytes = _resident_memory_bytes()
+        resp.dirty = self.ida.dirty
+        resp.last_activity = int(self.ida.last_activity or 0)
+        resp.pending_requests = self.pending_requests
+        return resp
+
+    def _stream_status(self, proto_body: bytes):
+        """Connect server stream: one enveloped WorkerStatus per interval until
+        the client disconnects."""
+        req = pb.StatusStreamRequest()
+        # Streaming requests arrive enveloped: 1 flag byte + 4 byte length
+        if len(proto_body) >= 5:
+            try:
+                req.ParseFromString(proto_body[5:])
+            except Exception:
+                logging.warning("Malformed StatusStream request; using default interval")
+        interval = max(req.interval_seconds, 1)
+
+        yield (
+            b"HTTP/1.1 200 OK\r\n"
+            b"Content-Type: application/connect+proto\r\n"
+            b"Connection: close\r\n"
+            b"\r\n"
+        )
+        while True:
+            msg = self._worker_status().SerializeToString()
+            yield struct.pack(">BI", 0, len(msg)) + msg
+            time.sleep(interval)
+
     def _extract_body(self, data: bytes) -> bytes:
         """Extract protobuf body from HTTP request"""
         # Find body after headers
@@ -689,6 +839,7 @@ class ConnectServer:
             "unimplemented": 501,
             "internal": 500,
             "unavailable": 503,
+            "canceled": 499,
         }
         http_code = status_map.get(code, 500)
 
@@ -698,3 +849,19 @@ class ConnectServer:
             + b"Content-Length: " + str(len(body)).encode() + b"\r\n"
             + b"\r\n" + body
         )
+
+
+def _resident_memory_bytes() -> int:
+    """Resident set size of this worker, or 0 when it cannot be determined."""
+    try:
+        with open("/proc/self/statm") as f:
+            return int(f.read().split()[1]) * os.sysconf("SC_PAGE_SIZE")
+    except (OSError, ValueError, IndexError):
+        pass
+    try:
+        import resource
+        peak = resource.getrusage(resource.RUSAGE_SELF).ru_maxrss
+        # ru_maxrss is bytes on macOS, kilobytes elsewhere
+        return peak if sys.platform == "darwin" else peak * 1024
+    except (ImportError, OSError):
+        return 0
@@ -30,6 +30,9 @@ class ErrorKind(str, Enum):
     API_INCOMPATIBLE = "api_incompatible"
     """IDA version lacks the required API -> use alternative code path."""
 
+    CANCELLED = "cancelled"
+    """The caller cancelled the call -> the database may be partially updated."""
+
     INTERNAL = "internal"
     """Unexpected internal error."""
 
@@ -138,6 +141,15 @@ class IDAError(Exception):
             context={"api": api_name},
         )
 
+    @classmethod
+    def cancelled(cls, operation: str = "") -> "IDAError":
+        return cls(
+            ErrorKind.CANCELLED,
+            "Cancelled before completion; changes made so far were kept",
+            status=ErrorStatus.TEMPORARY,
+            operation=operation,
+        )
+
     @classmethod
     def internal(
         cls, message: str, *, operation: str = "", **ctx: object
@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bida/worker/v1/service.proto\x12\rida.worker.v1\">\n\x11OpenBinaryRequest\x12\x13\n\x0b\x62inary_path\x18\x01 \x01(\t\x12\x14\n\x0c\x61uto_analyze\x18\x02 \x01(\x08\"a\n\x12OpenBinaryResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x16\n\x0ehas_decompiler\x18\x03 \x01(\x08\x12\x13\n\x0b\x62inary_path\x18\x04 \x01(\t\"#\n\x13\x43loseSessionRequest\x12\x0c\n\x04save\x18\x01 \x01(\x08\"6\n\x14\x43loseSessionResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x15\n\x13SaveDatabaseRequest\"X\n\x14SaveDatabaseResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x03\x12\r\n\x05\x64irty\x18\x04 \x01(\x08\"\x14\n\x12PlanAndWaitRequest\"O\n\x13PlanAndWaitResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\"\x17\n\x15GetSessionInfoRequest\"\x99\x01\n\x16GetSessionInfoResponse\x12\x13\n\x0b\x62inary_path\x18\x01 \x01(\t\x12\x11\n\topened_at\x18\x02 \x01(\x03\x12\x15\n\rlast_activity\x18\x03 \x01(\x03\x12\x16\n\x0ehas_decompiler\x18\x04 \x01(\x08\x12\x14\n\x0c\x61uto_running\x18\x05 \x01(\x08\x12\x12\n\nauto_state\x18\x06 \x01(\t\"0\n\x0fGetBytesRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04size\x18\x02 \x01(\r\"/\n\x10GetBytesResponse\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"#\n\x10GetDisasmRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x11GetDisasmResponse\x12\x0e\n\x06\x64isasm\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"+\n\x18GetFunctionDisasmRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"?\n\x19GetFunctionDisasmResponse\x12\x13\n\x0b\x64isassembly\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\'\n\x14GetDecompiledRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x15GetDecompiledResponse\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\")\n\x16GetFunctionNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"6\n\x17GetFunctionNameResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x14\n\x12GetSegmentsRequest\"l\n\x07Segment\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x11\n\tseg_class\x18\x04 \x01(\t\x12\x13\n\x0bpermissions\x18\x05 \x01(\r\x12\x0f\n\x07\x62itness\x18\x06 \x01(\r\"N\n\x13GetSegmentsResponse\x12(\n\x08segments\x18\x01 \x03(\x0b\x32\x16.ida.worker.v1.Segment\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x15\n\x13GetFunctionsRequest\")\n\x08\x46unction\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\"Q\n\x14GetFunctionsResponse\x12*\n\tfunctions\x18\x01 \x03(\x0b\x32\x17.ida.worker.v1.Function\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11GetXRefsToRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\".\n\x04XRef\x12\x0c\n\x04\x66rom\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\r\x12\n\n\x02to\x18\x03 \x01(\x04\"G\n\x12GetXRefsToResponse\x12\"\n\x05xrefs\x18\x01 \x03(\x0b\x32\x13.ida.worker.v1.XRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"&\n\x13GetXRefsFromRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"I\n\x14GetXRefsFromResponse\x12\"\n\x05xrefs\x18\x01 \x03(\x0b\x32\x13.ida.worker.v1.XRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"%\n\x12GetDataRefsRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"%\n\x07\x44\x61taRef\x12\x0c\n\x04\x66rom\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\r\"J\n\x13GetDataRefsResponse\x12$\n\x04refs\x18\x01 \x03(\x0b\x32\x16.ida.worker.v1.DataRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"(\n\x15GetStringXRefsRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"N\n\nStringXRef\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x18\n\x10\x66unction_address\x18\x02 \x01(\x04\x12\x15\n\rfunction_name\x18\x03 \x01(\t\"P\n\x16GetStringXRefsResponse\x12\'\n\x04refs\x18\x01 \x03(\x0b\x32\x19.ida.worker.v1.StringXRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x13\n\x11GetImportsRequest\"H\n\x06Import\x12\x0e\n\x06module\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\x04\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07ordinal\x18\x04 \x01(\x04\"K\n\x12GetImportsResponse\x12&\n\x07imports\x18\x01 \x03(\x0b\x32\x15.ida.worker.v1.Import\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x13\n\x11GetExportsRequest\"G\n\x06\x45xport\x12\r\n\x05index\x18\x01 \x01(\x04\x12\x0f\n\x07ordinal\x18\x02 \x01(\x04\x12\x0f\n\x07\x61\x64\x64ress\x18\x03 \x01(\x04\x12\x0c\n\x04name\x18\x04 \x01(\t\"K\n\x12GetExportsResponse\x12&\n\x07\x65xports\x18\x01 \x03(\x0b\x32\x15.ida.worker.v1.Export\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x16\n\x14GetEntryPointRequest\"7\n\x15GetEntryPointResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"2\n\x11GetStringsRequest\x12\x0e\n\x06offset\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\",\n\nStringItem\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\r\n\x05value\x18\x02 \x01(\t\"}\n\x12GetStringsResponse\x12*\n\x07strings\x18\x01 \x03(\x0b\x32\x19.ida.worker.v1.StringItem\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x05\x12\x0e\n\x06offset\x18\x04 \x01(\x05\x12\r\n\x05\x63ount\x18\x05 \x01(\x05\"&\n\x13MakeFunctionRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"6\n\x14MakeFunctionResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"O\n\x13ImportIl2CppRequest\x12\x13\n\x0bscript_path\x18\x01 \x01(\t\x12\x13\n\x0bil2cpp_path\x18\x02 \x01(\t\x12\x0e\n\x06\x66ields\x18\x03 \x03(\t\"\xe9\x01\n\x14ImportIl2CppResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\x12\x17\n\x0f\x66unctions_named\x18\x04 \x01(\r\x12\x15\n\rstrings_named\x18\x05 \x01(\r\x12\x16\n\x0emetadata_named\x18\x06 \x01(\r\x12\x18\n\x10metadata_methods\x18\x07 \x01(\r\x12\x19\n\x11\x66unctions_defined\x18\x08 \x01(\r\x12\x1a\n\x12signatures_applied\x18\t \x01(\r\"3\n\x14ImportFlutterRequest\x12\x1b\n\x13\x62lutter_output_path\x18\x01 \x01(\t\"\x85\x01\n\x15ImportFlutterResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\x12\x19\n\x11\x66unctions_created\x18\x04 \x01(\r\x12\x17\n\x0f\x66unctions_named\x18\x05 \x01(\r\"$\n\x11GetDwordAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x12GetDwordAtResponse\x12\r\n\x05value\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11GetQwordAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x12GetQwordAtResponse\x12\r\n\x05value\x18\x01 \x01(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\".\n\x1bGetInstructionLengthRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"=\n\x1cGetInstructionLengthResponse\x12\x0e\n\x06length\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\r\n\x0bPingRequest\"\x1d\n\x0cPingResponse\x12\r\n\x05\x61live\x18\x01 \x01(\x08\"/\n\x13StatusStreamRequest\x12\x18\n\x10interval_seconds\x18\x01 \x01(\r\"w\n\x0cWorkerStatus\x12\x11\n\ttimestamp\x18\x01 \x01(\x03\x12\x14\n\x0cmemory_bytes\x18\x02 \x01(\x04\x12\r\n\x05\x64irty\x18\x03 \x01(\x08\x12\x15\n\rlast_activity\x18\x04 \x01(\x03\x12\x18\n\x10pending_requests\x18\x05 \x01(\r\"I\n\x11SetCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x02 \x01(\t\x12\x12\n\nrepeatable\x18\x03 \x01(\x08\"4\n\x12SetCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"8\n\x11GetCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x12\n\nrepeatable\x18\x02 \x01(\x08\"4\n\x12GetCommentResponse\x12\x0f\n\x07\x63omment\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"9\n\x15SetFuncCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x02 \x01(\t\"8\n\x16SetFuncCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"T\n\x12SetLvarTypeRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x11\n\tlvar_name\x18\x02 \x01(\t\x12\x11\n\tlvar_type\x18\x03 \x01(\t\"5\n\x13SetLvarTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"R\n\x11RenameLvarRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x11\n\tlvar_name\x18\x02 \x01(\t\x12\x10\n\x08new_name\x18\x03 \x01(\t\"4\n\x12RenameLvarResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"Y\n\x1bSetDecompilerCommentRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x03 \x01(\t\">\n\x1cSetDecompilerCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\":\n\x11GetGlobalsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"=\n\x0eGlobalVariable\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\"S\n\x12GetGlobalsResponse\x12.\n\x07globals\x18\x01 \x03(\x0b\x32\x1d.ida.worker.v1.GlobalVariable\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"5\n\x14SetGlobalTypeRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\t\"7\n\x15SetGlobalTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"8\n\x13RenameGlobalRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x10\n\x08new_name\x18\x02 \x01(\t\"6\n\x14RenameGlobalResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"<\n\x15\x44\x61taReadStringRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x12\n\nmax_length\x18\x02 \x01(\r\"6\n\x16\x44\x61taReadStringResponse\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"&\n\x13\x44\x61taReadByteRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x14\x44\x61taReadByteResponse\x12\r\n\x05value\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\";\n\x12ListStructsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"7\n\rStructSummary\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04size\x18\x03 \x01(\r\"S\n\x13ListStructsResponse\x12-\n\x07structs\x18\x01 \x03(\x0b\x32\x1c.ida.worker.v1.StructSummary\x12\r\n\x05\x65rror\x18\x02 \x01(\t\" \n\x10GetStructRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"H\n\x0cStructMember\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\r\x12\x0c\n\x04size\x18\x03 \x01(\r\x12\x0c\n\x04type\x18\x04 \x01(\t\"x\n\x11GetStructResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04size\x18\x03 \x01(\r\x12,\n\x07members\x18\x04 \x03(\x0b\x32\x1b.ida.worker.v1.StructMember\x12\r\n\x05\x65rror\x18\x05 \x01(\t\"9\n\x10ListEnumsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"\'\n\x0b\x45numSummary\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\"M\n\x11ListEnumsResponse\x12)\n\x05\x65nums\x18\x01 \x03(\x0b\x32\x1a.ida.worker.v1.EnumSummary\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x1e\n\x0eGetEnumRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\")\n\nEnumMember\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04\"f\n\x0fGetEnumResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12*\n\x07members\x18\x03 \x03(\x0b\x32\x19.ida.worker.v1.EnumMember\x12\r\n\x05\x65rror\x18\x04 \x01(\t\")\n\x16GetFunctionInfoRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"o\n\rFunctionFlags\x12\x12\n\nis_library\x18\x01 \x01(\x08\x12\x10\n\x08is_thunk\x18\x02 \x01(\x08\x12\x11\n\tno_return\x18\x03 \x01(\x08\x12\x12\n\nhas_farseg\x18\x04 \x01(\x08\x12\x11\n\tis_static\x18\x05 \x01(\x08\"\xf5\x01\n\x17GetFunctionInfoResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05start\x18\x03 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x04\x12\x0c\n\x04size\x18\x05 \x01(\r\x12\x12\n\nframe_size\x18\x06 \x01(\r\x12+\n\x05\x66lags\x18\x07 \x01(\x0b\x32\x1c.ida.worker.v1.FunctionFlags\x12\x1a\n\x12\x63\x61lling_convention\x18\x08 \x01(\t\x12\x13\n\x0breturn_type\x18\t \x01(\t\x12\x10\n\x08num_args\x18\n \x01(\r\x12\r\n\x05\x65rror\x18\x0b \x01(\t\"#\n\x10GetTypeAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"\xca\x01\n\x11GetTypeAtResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\r\x12\x0e\n\x06is_ptr\x18\x04 \x01(\x08\x12\x0f\n\x07is_func\x18\x05 \x01(\x08\x12\x10\n\x08is_array\x18\x06 \x01(\x08\x12\x11\n\tis_struct\x18\x07 \x01(\x08\x12\x10\n\x08is_union\x18\x08 \x01(\x08\x12\x0f\n\x07is_enum\x18\t \x01(\x08\x12\x10\n\x08has_type\x18\n \x01(\x08\x12\r\n\x05\x65rror\x18\x0b \x01(\t\"S\n\x11\x46indBinaryRequest\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0f\n\x07pattern\x18\x03 \x01(\t\x12\x11\n\tsearch_up\x18\x04 \x01(\x08\"6\n\x12\x46indBinaryResponse\x12\x11\n\taddresses\x18\x01 \x03(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"f\n\x0f\x46indTextRequest\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0e\n\x06needle\x18\x03 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x04 \x01(\x08\x12\x0f\n\x07unicode\x18\x05 \x01(\x08\"4\n\x10\x46indTextResponse\x12\x11\n\taddresses\x18\x01 \x03(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"(\n\x15GetFuncCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"8\n\x16GetFuncCommentResponse\x12\x0f\n\x07\x63omment\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"/\n\x0eSetNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\"1\n\x0fSetNameResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"!\n\x0eGetNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\".\n\x0fGetNameResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11\x44\x65leteNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x12\x44\x65leteNameResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"<\n\x16SetFunctionTypeRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x11\n\tprototype\x18\x02 \x01(\t\"9\n\x17SetFunctionTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t2\xca\x03\n\x0eSessionControl\x12Q\n\nOpenBinary\x12 .ida.worker.v1.OpenBinaryRequest\x1a!.ida.worker.v1.OpenBinaryResponse\x12W\n\x0c\x43loseSession\x12\".ida.worker.v1.CloseSessionRequest\x1a#.ida.worker.v1.CloseSessionResponse\x12W\n\x0cSaveDatabase\x12\".ida.worker.v1.SaveDatabaseRequest\x1a#.ida.worker.v1.SaveDatabaseResponse\x12T\n\x0bPlanAndWait\x12!.ida.worker.v1.PlanAndWaitRequest\x1a\".ida.worker.v1.PlanAndWaitResponse\x12]\n\x0eGetSessionInfo\x12$.ida.worker.v1.GetSessionInfoRequest\x1a%.ida.worker.v1.GetSessionInfoResponse2\xf5\x1e\n\rAnalysisTools\x12K\n\x08GetBytes\x12\x1e.ida.worker.v1.GetBytesRequest\x1a\x1f.ida.worker.v1.GetBytesResponse\x12N\n\tGetDisasm\x12\x1f.ida.worker.v1.GetDisasmRequest\x1a .ida.worker.v1.GetDisasmResponse\x12\x66\n\x11GetFunctionDisasm\x12\'.ida.worker.v1.GetFunctionDisasmRequest\x1a(.ida.worker.v1.GetFunctionDisasmResponse\x12Z\n\rGetDecompiled\x12#.ida.worker.v1.GetDecompiledRequest\x1a$.ida.worker.v1.GetDecompiledResponse\x12`\n\x0fGetFunctionName\x12%.ida.worker.v1.GetFunctionNameRequest\x1a&.ida.worker.v1.GetFunctionNameResponse\x12T\n\x0bGetSegments\x12!.ida.worker.v1.GetSegmentsRequest\x1a\".ida.worker.v1.GetSegmentsResponse\x12W\n\x0cGetFunctions\x12\".ida.worker.v1.GetFunctionsRequest\x1a#.ida.worker.v1.GetFunctionsResponse\x12Q\n\nGetXRefsTo\x12 .ida.worker.v1.GetXRefsToRequest\x1a!.ida.worker.v1.GetXRefsToResponse\x12W\n\x0cGetXRefsFrom\x12\".ida.worker.v1.GetXRefsFromRequest\x1a#.ida.worker.v1.GetXRefsFromResponse\x12T\n\x0bGetDataRefs\x12!.ida.worker.v1.GetDataRefsRequest\x1a\".ida.worker.v1.GetDataRefsResponse\x12]\n\x0eGetStringXRefs\x12$.ida.worker.v1.GetStringXRefsRequest\x1a%.ida.worker.v1.GetStringXRefsResponse\x12Q\n\nGetImports\x12 .ida.worker.v1.GetImportsRequest\x1a!.ida.worker.v1.GetImportsResponse\x12Q\n\nGetExports\x12 .ida.worker.v1.GetExportsRequest\x1a!.ida.worker.v1.GetExportsResponse\x12Z\n\rGetEntryPoint\x12#.ida.worker.v1.GetEntryPointRequest\x1a$.ida.worker.v1.GetEntryPointResponse\x12Q\n\nGetStrings\x12 .ida.worker.v1.GetStringsRequest\x1a!.ida.worker.v1.GetStringsResponse\x12W\n\x0cMakeFunction\x12\".ida.worker.v1.MakeFunctionRequest\x1a#.ida.worker.v1.MakeFunctionResponse\x12W\n\x0cImportIl2Cpp\x12\".ida.worker.v1.ImportIl2CppRequest\x1a#.ida.worker.v1.ImportIl2CppResponse\x12Z\n\rImportFlutter\x12#.ida.worker.v1.ImportFlutterRequest\x1a$.ida.worker.v1.ImportFlutterResponse\x12Q\n\nGetGlobals\x12 .ida.worker.v1.GetGlobalsRequest\x1a!.ida.worker.v1.GetGlobalsResponse\x12Z\n\rSetGlobalType\x12#.ida.worker.v1.SetGlobalTypeRequest\x1a$.ida.worker.v1.SetGlobalTypeResponse\x12W\n\x0cRenameGlobal\x12\".ida.worker.v1.RenameGlobalRequest\x1a#.ida.worker.v1.RenameGlobalResponse\x12]\n\x0e\x44\x61taReadString\x12$.ida.worker.v1.DataReadStringRequest\x1a%.ida.worker.v1.DataReadStringResponse\x12W\n\x0c\x44\x61taReadByte\x12\".ida.worker.v1.DataReadByteRequest\x1a#.ida.worker.v1.DataReadByteResponse\x12Q\n\nFindBinary\x12 .ida.worker.v1.FindBinaryRequest\x1a!.ida.worker.v1.FindBinaryResponse\x12K\n\x08\x46indText\x12\x1e.ida.worker.v1.FindTextRequest\x1a\x1f.ida.worker.v1.FindTextResponse\x12T\n\x0bListStructs\x12!.ida.worker.v1.ListStructsRequest\x1a\".ida.worker.v1.ListStructsResponse\x12N\n\tGetStruct\x12\x1f.ida.worker.v1.GetStructRequest\x1a .ida.worker.v1.GetStructResponse\x12N\n\tListEnums\x12\x1f.ida.worker.v1.ListEnumsRequest\x1a .ida.worker.v1.ListEnumsResponse\x12H\n\x07GetEnum\x12\x1d.ida.worker.v1.GetEnumRequest\x1a\x1e.ida.worker.v1.GetEnumResponse\x12`\n\x0fGetFunctionInfo\x12%.ida.worker.v1.GetFunctionInfoRequest\x1a&.ida.worker.v1.GetFunctionInfoResponse\x12N\n\tGetTypeAt\x12\x1f.ida.worker.v1.GetTypeAtRequest\x1a .ida.worker.v1.GetTypeAtResponse\x12Q\n\nGetDwordAt\x12 .ida.worker.v1.GetDwordAtRequest\x1a!.ida.worker.v1.GetDwordAtResponse\x12Q\n\nGetQwordAt\x12 .ida.worker.v1.GetQwordAtRequest\x1a!.ida.worker.v1.GetQwordAtResponse\x12o\n\x14GetInstructionLength\x12*.ida.worker.v1.GetInstructionLengthRequest\x1a+.ida.worker.v1.GetInstructionLengthResponse\x12Q\n\nSetComment\x12 .ida.worker.v1.SetCommentRequest\x1a!.ida.worker.v1.SetCommentResponse\x12Q\n\nGetComment\x12 .ida.worker.v1.GetCommentRequest\x1a!.ida.worker.v1.GetCommentResponse\x12]\n\x0eSetFuncComment\x12$.ida.worker.v1.SetFuncCommentRequest\x1a%.ida.worker.v1.SetFuncCommentResponse\x12]\n\x0eGetFuncComment\x12$.ida.worker.v1.GetFuncCommentRequest\x1a%.ida.worker.v1.GetFuncCommentResponse\x12T\n\x0bSetLvarType\x12!.ida.worker.v1.SetLvarTypeRequest\x1a\".ida.worker.v1.SetLvarTypeResponse\x12Q\n\nRenameLvar\x12 .ida.worker.v1.RenameLvarRequest\x1a!.ida.worker.v1.RenameLvarResponse\x12o\n\x14SetDecompilerComment\x12*.ida.worker.v1.SetDecompilerCommentRequest\x1a+.ida.worker.v1.SetDecompilerCommentResponse\x12H\n\x07SetName\x12\x1d.ida.worker.v1.SetNameRequest\x1a\x1e.ida.worker.v1.SetNameResponse\x12H\n\x07GetName\x12\x1d.ida.worker.v1.GetNameRequest\x1a\x1e.ida.worker.v1.GetNameResponse\x12Q\n\nDeleteName\x12 .ida.worker.v1.DeleteNameRequest\x1a!.ida.worker.v1.DeleteNameResponse\x12`\n\x0fSetFunctionType\x12%.ida.worker.v1.SetFunctionTypeRequest\x1a&.ida.worker.v1.SetFunctionTypeResponse2\xa1\x01\n\x0bHealthcheck\x12?\n\x04Ping\x12\x1a.ida.worker.v1.PingRequest\x1a\x1b.ida.worker.v1.PingResponse\x12Q\n\x0cStatusStream\x12\".ida.worker.v1.StatusStreamRequest\x1a\x1b.ida.worker.v1.WorkerStatus0\x01\x42<Z:github.com/zboralski/ida-headless-mcp/ida/worker/v1;workerb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bida/worker/v1/service.proto\x12\rida.worker.v1\"U\n\x11OpenBinaryRequest\x12\x13\n\x0b\x62inary_path\x18\x01 \x01(\t\x12\x14\n\x0c\x61uto_analyze\x18\x02 \x01(\x08\x12\x15\n\rdatabase_path\x18\x03 \x01(\t\"\x85\x01\n\x12OpenBinaryResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x16\n\x0ehas_decompiler\x18\x03 \x01(\x08\x12\x13\n\x0b\x62inary_path\x18\x04 \x01(\t\x12\x11\n\tprocessor\x18\x05 \x01(\t\x12\x0f\n\x07\x62itness\x18\x06 \x01(\x05\"#\n\x13\x43loseSessionRequest\x12\x0c\n\x04save\x18\x01 \x01(\x08\"6\n\x14\x43loseSessionResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x15\n\x13SaveDatabaseRequest\"X\n\x14SaveDatabaseResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x03\x12\r\n\x05\x64irty\x18\x04 \x01(\x08\"\x14\n\x12PlanAndWaitRequest\"O\n\x13PlanAndWaitResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\"\x17\n\x15GetSessionInfoRequest\"\x99\x01\n\x16GetSessionInfoResponse\x12\x13\n\x0b\x62inary_path\x18\x01 \x01(\t\x12\x11\n\topened_at\x18\x02 \x01(\x03\x12\x15\n\rlast_activity\x18\x03 \x01(\x03\x12\x16\n\x0ehas_decompiler\x18\x04 \x01(\x08\x12\x14\n\x0c\x61uto_running\x18\x05 \x01(\x08\x12\x12\n\nauto_state\x18\x06 \x01(\t\"4\n\rCancelRequest\x12\x12\n\nrequest_id\x18\x01 \x01(\t\x12\x0f\n\x07wait_ms\x18\x02 \x01(\r\"0\n\x0e\x43\x61ncelResponse\x12\r\n\x05\x66ound\x18\x01 \x01(\x08\x12\x0f\n\x07stopped\x18\x02 \x01(\x08\"0\n\x0fGetBytesRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04size\x18\x02 \x01(\r\"/\n\x10GetBytesResponse\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"#\n\x10GetDisasmRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x11GetDisasmResponse\x12\x0e\n\x06\x64isasm\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"+\n\x18GetFunctionDisasmRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"?\n\x19GetFunctionDisasmResponse\x12\x13\n\x0b\x64isassembly\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\'\n\x14GetDecompiledRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x15GetDecompiledResponse\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\")\n\x16GetFunctionNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"6\n\x17GetFunctionNameResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x14\n\x12GetSegmentsRequest\"l\n\x07Segment\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x11\n\tseg_class\x18\x04 \x01(\t\x12\x13\n\x0bpermissions\x18\x05 \x01(\r\x12\x0f\n\x07\x62itness\x18\x06 \x01(\r\"N\n\x13GetSegmentsResponse\x12(\n\x08segments\x18\x01 \x03(\x0b\x32\x16.ida.worker.v1.Segment\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x15\n\x13GetFunctionsRequest\")\n\x08\x46unction\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\"Q\n\x14GetFunctionsResponse\x12*\n\tfunctions\x18\x01 \x03(\x0b\x32\x17.ida.worker.v1.Function\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11GetXRefsToRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\".\n\x04XRef\x12\x0c\n\x04\x66rom\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\r\x12\n\n\x02to\x18\x03 \x01(\x04\"G\n\x12GetXRefsToResponse\x12\"\n\x05xrefs\x18\x01 \x03(\x0b\x32\x13.ida.worker.v1.XRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"&\n\x13GetXRefsFromRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"I\n\x14GetXRefsFromResponse\x12\"\n\x05xrefs\x18\x01 \x03(\x0b\x32\x13.ida.worker.v1.XRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"%\n\x12GetDataRefsRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"%\n\x07\x44\x61taRef\x12\x0c\n\x04\x66rom\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\r\"J\n\x13GetDataRefsResponse\x12$\n\x04refs\x18\x01 \x03(\x0b\x32\x16.ida.worker.v1.DataRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"(\n\x15GetStringXRefsRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"N\n\nStringXRef\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x18\n\x10\x66unction_address\x18\x02 \x01(\x04\x12\x15\n\rfunction_name\x18\x03 \x01(\t\"P\n\x16GetStringXRefsResponse\x12\'\n\x04refs\x18\x01 \x03(\x0b\x32\x19.ida.worker.v1.StringXRef\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x13\n\x11GetImportsRequest\"H\n\x06Import\x12\x0e\n\x06module\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\x04\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07ordinal\x18\x04 \x01(\x04\"K\n\x12GetImportsResponse\x12&\n\x07imports\x18\x01 \x03(\x0b\x32\x15.ida.worker.v1.Import\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x13\n\x11GetExportsRequest\"G\n\x06\x45xport\x12\r\n\x05index\x18\x01 \x01(\x04\x12\x0f\n\x07ordinal\x18\x02 \x01(\x04\x12\x0f\n\x07\x61\x64\x64ress\x18\x03 \x01(\x04\x12\x0c\n\x04name\x18\x04 \x01(\t\"K\n\x12GetExportsResponse\x12&\n\x07\x65xports\x18\x01 \x03(\x0b\x32\x15.ida.worker.v1.Export\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x16\n\x14GetEntryPointRequest\"7\n\x15GetEntryPointResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"2\n\x11GetStringsRequest\x12\x0e\n\x06offset\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\",\n\nStringItem\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\r\n\x05value\x18\x02 \x01(\t\"}\n\x12GetStringsResponse\x12*\n\x07strings\x18\x01 \x03(\x0b\x32\x19.ida.worker.v1.StringItem\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x05\x12\x0e\n\x06offset\x18\x04 \x01(\x05\x12\r\n\x05\x63ount\x18\x05 \x01(\x05\"&\n\x13MakeFunctionRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"6\n\x14MakeFunctionResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"O\n\x13ImportIl2CppRequest\x12\x13\n\x0bscript_path\x18\x01 \x01(\t\x12\x13\n\x0bil2cpp_path\x18\x02 \x01(\t\x12\x0e\n\x06\x66ields\x18\x03 \x03(\t\"\xe9\x01\n\x14ImportIl2CppResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\x12\x17\n\x0f\x66unctions_named\x18\x04 \x01(\r\x12\x15\n\rstrings_named\x18\x05 \x01(\r\x12\x16\n\x0emetadata_named\x18\x06 \x01(\r\x12\x18\n\x10metadata_methods\x18\x07 \x01(\r\x12\x19\n\x11\x66unctions_defined\x18\x08 \x01(\r\x12\x1a\n\x12signatures_applied\x18\t \x01(\r\"3\n\x14ImportFlutterRequest\x12\x1b\n\x13\x62lutter_output_path\x18\x01 \x01(\t\"\x85\x01\n\x15ImportFlutterResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x01\x12\x19\n\x11\x66unctions_created\x18\x04 \x01(\r\x12\x17\n\x0f\x66unctions_named\x18\x05 \x01(\r\"$\n\x11GetDwordAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x12GetDwordAtResponse\x12\r\n\x05value\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11GetQwordAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"2\n\x12GetQwordAtResponse\x12\r\n\x05value\x18\x01 \x01(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\".\n\x1bGetInstructionLengthRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"=\n\x1cGetInstructionLengthResponse\x12\x0e\n\x06length\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\r\n\x0bPingRequest\"\x1d\n\x0cPingResponse\x12\r\n\x05\x61live\x18\x01 \x01(\x08\"/\n\x13StatusStreamRequest\x12\x18\n\x10interval_seconds\x18\x01 \x01(\r\"w\n\x0cWorkerStatus\x12\x11\n\ttimestamp\x18\x01 \x01(\x03\x12\x14\n\x0cmemory_bytes\x18\x02 \x01(\x04\x12\r\n\x05\x64irty\x18\x03 \x01(\x08\x12\x15\n\rlast_activity\x18\x04 \x01(\x03\x12\x18\n\x10pending_requests\x18\x05 \x01(\r\"I\n\x11SetCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x02 \x01(\t\x12\x12\n\nrepeatable\x18\x03 \x01(\x08\"4\n\x12SetCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"8\n\x11GetCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x12\n\nrepeatable\x18\x02 \x01(\x08\"4\n\x12GetCommentResponse\x12\x0f\n\x07\x63omment\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"9\n\x15SetFuncCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x02 \x01(\t\"8\n\x16SetFuncCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"T\n\x12SetLvarTypeRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x11\n\tlvar_name\x18\x02 \x01(\t\x12\x11\n\tlvar_type\x18\x03 \x01(\t\"5\n\x13SetLvarTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"R\n\x11RenameLvarRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x11\n\tlvar_name\x18\x02 \x01(\t\x12\x10\n\x08new_name\x18\x03 \x01(\t\"4\n\x12RenameLvarResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"Y\n\x1bSetDecompilerCommentRequest\x12\x18\n\x10\x66unction_address\x18\x01 \x01(\x04\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\x04\x12\x0f\n\x07\x63omment\x18\x03 \x01(\t\">\n\x1cSetDecompilerCommentResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\":\n\x11GetGlobalsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"=\n\x0eGlobalVariable\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\"S\n\x12GetGlobalsResponse\x12.\n\x07globals\x18\x01 \x03(\x0b\x32\x1d.ida.worker.v1.GlobalVariable\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"5\n\x14SetGlobalTypeRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\t\"7\n\x15SetGlobalTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"8\n\x13RenameGlobalRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x10\n\x08new_name\x18\x02 \x01(\t\"6\n\x14RenameGlobalResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"<\n\x15\x44\x61taReadStringRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x12\n\nmax_length\x18\x02 \x01(\r\"6\n\x16\x44\x61taReadStringResponse\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"&\n\x13\x44\x61taReadByteRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x14\x44\x61taReadByteResponse\x12\r\n\x05value\x18\x01 \x01(\r\x12\r\n\x05\x65rror\x18\x02 \x01(\t\";\n\x12ListStructsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"7\n\rStructSummary\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04size\x18\x03 \x01(\r\"S\n\x13ListStructsResponse\x12-\n\x07structs\x18\x01 \x03(\x0b\x32\x1c.ida.worker.v1.StructSummary\x12\r\n\x05\x65rror\x18\x02 \x01(\t\" \n\x10GetStructRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"H\n\x0cStructMember\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\r\x12\x0c\n\x04size\x18\x03 \x01(\r\x12\x0c\n\x04type\x18\x04 \x01(\t\"x\n\x11GetStructResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04size\x18\x03 \x01(\r\x12,\n\x07members\x18\x04 \x03(\x0b\x32\x1b.ida.worker.v1.StructMember\x12\r\n\x05\x65rror\x18\x05 \x01(\t\"9\n\x10ListEnumsRequest\x12\r\n\x05regex\x18\x01 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x02 \x01(\x08\"\'\n\x0b\x45numSummary\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\"M\n\x11ListEnumsResponse\x12)\n\x05\x65nums\x18\x01 \x03(\x0b\x32\x1a.ida.worker.v1.EnumSummary\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x1e\n\x0eGetEnumRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\")\n\nEnumMember\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04\"f\n\x0fGetEnumResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12*\n\x07members\x18\x03 \x03(\x0b\x32\x19.ida.worker.v1.EnumMember\x12\r\n\x05\x65rror\x18\x04 \x01(\t\")\n\x16GetFunctionInfoRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"o\n\rFunctionFlags\x12\x12\n\nis_library\x18\x01 \x01(\x08\x12\x10\n\x08is_thunk\x18\x02 \x01(\x08\x12\x11\n\tno_return\x18\x03 \x01(\x08\x12\x12\n\nhas_farseg\x18\x04 \x01(\x08\x12\x11\n\tis_static\x18\x05 \x01(\x08\"\xf5\x01\n\x17GetFunctionInfoResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05start\x18\x03 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x04\x12\x0c\n\x04size\x18\x05 \x01(\r\x12\x12\n\nframe_size\x18\x06 \x01(\r\x12+\n\x05\x66lags\x18\x07 \x01(\x0b\x32\x1c.ida.worker.v1.FunctionFlags\x12\x1a\n\x12\x63\x61lling_convention\x18\x08 \x01(\t\x12\x13\n\x0breturn_type\x18\t \x01(\t\x12\x10\n\x08num_args\x18\n \x01(\r\x12\r\n\x05\x65rror\x18\x0b \x01(\t\"#\n\x10GetTypeAtRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"\xca\x01\n\x11GetTypeAtResponse\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\r\x12\x0e\n\x06is_ptr\x18\x04 \x01(\x08\x12\x0f\n\x07is_func\x18\x05 \x01(\x08\x12\x10\n\x08is_array\x18\x06 \x01(\x08\x12\x11\n\tis_struct\x18\x07 \x01(\x08\x12\x10\n\x08is_union\x18\x08 \x01(\x08\x12\x0f\n\x07is_enum\x18\t \x01(\x08\x12\x10\n\x08has_type\x18\n \x01(\x08\x12\r\n\x05\x65rror\x18\x0b \x01(\t\"S\n\x11\x46indBinaryRequest\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0f\n\x07pattern\x18\x03 \x01(\t\x12\x11\n\tsearch_up\x18\x04 \x01(\x08\"6\n\x12\x46indBinaryResponse\x12\x11\n\taddresses\x18\x01 \x03(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"f\n\x0f\x46indTextRequest\x12\r\n\x05start\x18\x01 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x04\x12\x0e\n\x06needle\x18\x03 \x01(\t\x12\x16\n\x0e\x63\x61se_sensitive\x18\x04 \x01(\x08\x12\x0f\n\x07unicode\x18\x05 \x01(\x08\"4\n\x10\x46indTextResponse\x12\x11\n\taddresses\x18\x01 \x03(\x04\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"(\n\x15GetFuncCommentRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"8\n\x16GetFuncCommentResponse\x12\x0f\n\x07\x63omment\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"/\n\x0eSetNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x0c\n\x04name\x18\x02 \x01(\t\"1\n\x0fSetNameResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"!\n\x0eGetNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\".\n\x0fGetNameResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"$\n\x11\x44\x65leteNameRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\"4\n\x12\x44\x65leteNameResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"<\n\x16SetFunctionTypeRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\x04\x12\x11\n\tprototype\x18\x02 \x01(\t\"9\n\x17SetFunctionTypeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t2\x91\x04\n\x0eSessionControl\x12Q\n\nOpenBinary\x12 .ida.worker.v1.OpenBinaryRequest\x1a!.ida.worker.v1.OpenBinaryResponse\x12W\n\x0c\x43loseSession\x12\".ida.worker.v1.CloseSessionRequest\x1a#.ida.worker.v1.CloseSessionResponse\x12W\n\x0cSaveDatabase\x12\".ida.worker.v1.SaveDatabaseRequest\x1a#.ida.worker.v1.SaveDatabaseResponse\x12T\n\x0bPlanAndWait\x12!.ida.worker.v1.PlanAndWaitRequest\x1a\".ida.worker.v1.PlanAndWaitResponse\x12]\n\x0eGetSessionInfo\x12$.ida.worker.v1.GetSessionInfoRequest\x1a%.ida.worker.v1.GetSessionInfoResponse\x12\x45\n\x06\x43\x61ncel\x12\x1c.ida.worker.v1.CancelRequest\x1a\x1d.ida.worker.v1.CancelResponse2\xf5\x1e\n\rAnalysisTools\x12K\n\x08GetBytes\x12\x1e.ida.worker.v1.GetBytesRequest\x1a\x1f.ida.worker.v1.GetBytesResponse\x12N\n\tGetDisasm\x12\x1f.ida.worker.v1.GetDisasmRequest\x1a .ida.worker.v1.GetDisasmResponse\x12\x66\n\x11GetFunctionDisasm\x12\'.ida.worker.v1.GetFunctionDisasmRequest\x1a(.ida.worker.v1.GetFunctionDisasmResponse\x12Z\n\rGetDecompiled\x12#.ida.worker.v1.GetDecompiledRequest\x1a$.ida.worker.v1.GetDecompiledResponse\x12`\n\x0fGetFunctionName\x12%.ida.worker.v1.GetFunctionNameRequest\x1a&.ida.worker.v1.GetFunctionNameResponse\x12T\n\x0bGetSegments\x12!.ida.worker.v1.GetSegmentsRequest\x1a\".ida.worker.v1.GetSegmentsResponse\x12W\n\x0cGetFunctions\x12\".ida.worker.v1.GetFunctionsRequest\x1a#.ida.worker.v1.GetFunctionsResponse\x12Q\n\nGetXRefsTo\x12 .ida.worker.v1.GetXRefsToRequest\x1a!.ida.worker.v1.GetXRefsToResponse\x12W\n\x0cGetXRefsFrom\x12\".ida.worker.v1.GetXRefsFromRequest\x1a#.ida.worker.v1.GetXRefsFromResponse\x12T\n\x0bGetDataRefs\x12!.ida.worker.v1.GetDataRefsRequest\x1a\".ida.worker.v1.GetDataRefsResponse\x12]\n\x0eGetStringXRefs\x12$.ida.worker.v1.GetStringXRefsRequest\x1a%.ida.worker.v1.GetStringXRefsResponse\x12Q\n\nGetImports\x12 .ida.worker.v1.GetImportsRequest\x1a!.ida.worker.v1.GetImportsResponse\x12Q\n\nGetExports\x12 .ida.worker.v1.GetExportsRequest\x1a!.ida.worker.v1.GetExportsResponse\x12Z\n\rGetEntryPoint\x12#.ida.worker.v1.GetEntryPointRequest\x1a$.ida.worker.v1.GetEntryPointResponse\x12Q\n\nGetStrings\x12 .ida.worker.v1.GetStringsRequest\x1a!.ida.worker.v1.GetStringsResponse\x12W\n\x0cMakeFunction\x12\".ida.worker.v1.MakeFunctionRequest\x1a#.ida.worker.v1.MakeFunctionResponse\x12W\n\x0cImportIl2Cpp\x12\".ida.worker.v1.ImportIl2CppRequest\x1a#.ida.worker.v1.ImportIl2CppResponse\x12Z\n\rImportFlutter\x12#.ida.worker.v1.ImportFlutterRequest\x1a$.ida.worker.v1.ImportFlutterResponse\x12Q\n\nGetGlobals\x12 .ida.worker.v1.GetGlobalsRequest\x1a!.ida.worker.v1.GetGlobalsResponse\x12Z\n\rSetGlobalType\x12#.ida.worker.v1.SetGlobalTypeRequest\x1a$.ida.worker.v1.SetGlobalTypeResponse\x12W\n\x0cRenameGlobal\x12\".ida.worker.v1.RenameGlobalRequest\x1a#.ida.worker.v1.RenameGlobalResponse\x12]\n\x0e\x44\x61taReadString\x12$.ida.worker.v1.DataReadStringRequest\x1a%.ida.worker.v1.DataReadStringResponse\x12W\n\x0c\x44\x61taReadByte\x12\".ida.worker.v1.DataReadByteRequest\x1a#.ida.worker.v1.DataReadByteResponse\x12Q\n\nFindBinary\x12 .ida.worker.v1.FindBinaryRequest\x1a!.ida.worker.v1.FindBinaryResponse\x12K\n\x08\x46indText\x12\x1e.ida.worker.v1.FindTextRequest\x1a\x1f.ida.worker.v1.FindTextResponse\x12T\n\x0bListStructs\x12!.ida.worker.v1.ListStructsRequest\x1a\".ida.worker.v1.ListStructsResponse\x12N\n\tGetStruct\x12\x1f.ida.worker.v1.GetStructRequest\x1a .ida.worker.v1.GetStructResponse\x12N\n\tListEnums\x12\x1f.ida.worker.v1.ListEnumsRequest\x1a .ida.worker.v1.ListEnumsResponse\x12H\n\x07GetEnum\x12\x1d.ida.worker.v1.GetEnumRequest\x1a\x1e.ida.worker.v1.GetEnumResponse\x12`\n\x0fGetFunctionInfo\x12%.ida.worker.v1.GetFunctionInfoRequest\x1a&.ida.worker.v1.GetFunctionInfoResponse\x12N\n\tGetTypeAt\x12\x1f.ida.worker.v1.GetTypeAtRequest\x1a .ida.worker.v1.GetTypeAtResponse\x12Q\n\nGetDwordAt\x12 .ida.worker.v1.GetDwordAtRequest\x1a!.ida.worker.v1.GetDwordAtResponse\x12Q\n\nGetQwordAt\x12 .ida.worker.v1.GetQwordAtRequest\x1a!.ida.worker.v1.GetQwordAtResponse\x12o\n\x14GetInstructionLength\x12*.ida.worker.v1.GetInstructionLengthRequest\x1a+.ida.worker.v1.GetInstructionLengthResponse\x12Q\n\nSetComment\x12 .ida.worker.v1.SetCommentRequest\x1a!.ida.worker.v1.SetCommentResponse\x12Q\n\nGetComment\x12 .ida.worker.v1.GetCommentRequest\x1a!.ida.worker.v1.GetCommentResponse\x12]\n\x0eSetFuncComment\x12$.ida.worker.v1.SetFuncCommentRequest\x1a%.ida.worker.v1.SetFuncCommentResponse\x12]\n\x0eGetFuncComment\x12$.ida.worker.v1.GetFuncCommentRequest\x1a%.ida.worker.v1.GetFuncCommentResponse\x12T\n\x0bSetLvarType\x12!.ida.worker.v1.SetLvarTypeRequest\x1a\".ida.worker.v1.SetLvarTypeResponse\x12Q\n\nRenameLvar\x12 .ida.worker.v1.RenameLvarRequest\x1a!.ida.worker.v1.RenameLvarResponse\x12o\n\x14SetDecompilerComment\x12*.ida.worker.v1.SetDecompilerCommentRequest\x1a+.ida.worker.v1.SetDecompilerCommentResponse\x12H\n\x07SetName\x12\x1d.ida.worker.v1.SetNameRequest\x1a\x1e.ida.worker.v1.SetNameResponse\x12H\n\x07GetName\x12\x1d.ida.worker.v1.GetNameRequest\x1a\x1e.ida.worker.v1.GetNameResponse\x12Q\n\nDeleteName\x12 .ida.worker.v1.DeleteNameRequest\x1a!.ida.worker.v1.DeleteNameResponse\x12`\n\x0fSetFunctionType\x12%.ida.worker.v1.SetFunctionTypeRequest\x1a&.ida.worker.v1.SetFunctionTypeResponse2\xa1\x01\n\x0bHealthcheck\x12?\n\x04Ping\x12\x1a.ida.worker.v1.PingRequest\x1a\x1b.ida.worker.v1.PingResponse\x12Q\n\x0cStatusStream\x12\".ida.worker.v1.StatusStreamRequest\x1a\x1b.ida.worker.v1.WorkerStatus0\x01\x42<Z:github.com/zboralski/ida-headless-mcp/ida/worker/v1;workerb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -33,245 +33,249 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z:github.com/zboralski/ida-headless-mcp/ida/worker/v1;worker'
   _globals['_OPENBINARYREQUEST']._serialized_start=46
-  _globals['_OPENBINARYREQUEST']._serialized_end=108
-  _globals['_OPENBINARYRESPONSE']._serialized_start=110
-  _globals['_OPENBINARYRESPONSE']._serialized_end=207
-  _globals['_CLOSESESSIONREQUEST']._serialized_start=209
-  _globals['_CLOSESESSIONREQUEST']._serialized_end=244
-  _globals['_CLOSESESSIONRESPONSE']._serialized_start=246
-  _globals['_CLOSESESSIONRESPONSE']._serialized_end=300
-  _globals['_SAVEDATABASEREQUEST']._serialized_start=302
-  _globals['_SAVEDATABASEREQUEST']._serialized_end=323
-  _globals['_SAVEDATABASERESPONSE']._serialized_start=325
-  _globals['_SAVEDATABASERESPONSE']._serialized_end=413
-  _globals['_PLANANDWAITREQUEST']._serialized_start=415
-  _globals['_PLANANDWAITREQUEST']._serialized_end=435
-  _globals['_PLANANDWAITRESPONSE']._serialized_start=437
-  _globals['_PLANANDWAITRESPONSE']._serialized_end=516
-  _globals['_GETSESSIONINFOREQUEST']._serialized_start=518
-  _globals['_GETSESSIONINFOREQUEST']._serialized_end=541
-  _globals['_GETSESSIONINFORESPONSE']._serialized_start=544
-  _globals['_GETSESSIONINFORESPONSE']._serialized_end=697
-  _globals['_GETBYTESREQUEST']._serialized_start=699
-  _globals['_GETBYTESREQUEST']._serialized_end=747
-  _globals['_GETBYTESRESPONSE']._serialized_start=749
-  _globals['_GETBYTESRESPONSE']._serialized_end=796
-  _globals['_GETDISASMREQUEST']._serialized_start=798
-  _globals['_GETDISASMREQUEST']._serialized_end=833
-  _globals['_GETDISASMRESPONSE']._serialized_start=835
-  _globals['_GETDISASMRESPONSE']._serialized_end=885
-  _globals['_GETFUNCTIONDISASMREQUEST']._serialized_start=887
-  _globals['_GETFUNCTIONDISASMREQUEST']._serialized_end=930
-  _globals['_GETFUNCTIONDISASMRESPONSE']._serialized_start=932
-  _globals['_GETFUNCTIONDISASMRESPONSE']._serialized_end=995
-  _globals['_GETDECOMPILEDREQUEST']._serialized_start=997
-  _globals['_GETDECOMPILEDREQUEST']._serialized_end=1036
-  _globals['_GETDECOMPILEDRESPONSE']._serialized_start=1038
-  _globals['_GETDECOMPILEDRESPONSE']._serialized_end=1090
-  _globals['_GETFUNCTIONNAMEREQUEST']._serialized_start=1092
-  _globals['_GETFUNCTIONNAMEREQUEST']._serialized_end=1133
-  _globals['_GETFUNCTIONNAMERESPONSE']._serialized_start=1135
-  _globals['_GETFUNCTIONNAMERESPONSE']._serialized_end=1189
-  _globals['_GETSEGMENTSREQUEST']._serialized_start=1191
-  _globals['_GETSEGMENTSREQUEST']._serialized_end=1211
-  _globals['_SEGMENT']._serialized_start=1213
-  _globals['_SEGMENT']._serialized_end=1321
-  _globals['_GETSEGMENTSRESPONSE']._serialized_start=1323
-  _globals['_GETSEGMENTSRESPONSE']._serialized_end=1401
-  _globals['_GETFUNCTIONSREQUEST']._serialized_start=1403
-  _globals['_GETFUNCTIONSREQUEST']._serialized_end=1424
-  _globals['_FUNCTION']._serialized_start=1426
-  _globals['_FUNCTION']._serialized_end=1467
-  _globals['_GETFUNCTIONSRESPONSE']._serialized_start=1469
-  _globals['_GETFUNCTIONSRESPONSE']._serialized_end=1550
-  _globals['_GETXREFSTOREQUEST']._serialized_start=1552
-  _globals['_GETXREFSTOREQUEST']._serialized_end=1588
-  _globals['_XREF']._serialized_start=1590
-  _globals['_XREF']._serialized_end=1636
-  _globals['_GETXREFSTORESPONSE']._serialized_start=1638
-  _globals['_GETXREFSTORESPONSE']._serialized_end=1709
-  _globals['_GETXREFSFROMREQUEST']._serialized_start=1711
-  _globals['_GETXREFSFROMREQUEST']._serialized_end=1749
-  _globals['_GETXREFSFROMRESPONSE']._serialized_start=1751
-  _globals['_GETXREFSFROMRESPONSE']._serialized_end=1824
-  _globals['_GETDATAREFSREQUEST']._serialized_start=1826
-  _globals['_GETDATAREFSREQUEST']._serialized_end=1863
-  _globals['_DATAREF']._serialized_start=1865
-  _globals['_DATAREF']._serialized_end=1902
-  _globals['_GETDATAREFSRESPONSE']._serialized_start=1904
-  _globals['_GETDATAREFSRESPONSE']._serialized_end=1978
-  _globals['_GETSTRINGXREFSREQUEST']._serialized_start=1980
-  _globals['_GETSTRINGXREFSREQUEST']._serialized_end=2020
-  _globals['_STRINGXREF']._serialized_start=2022
-  _globals['_STRINGXREF']._serialized_end=2100
-  _globals['_GETSTRINGXREFSRESPONSE']._serialized_start=2102
-  _globals['_GETSTRINGXREFSRESPONSE']._serialized_end=2182
-  _globals['_GETIMPORTSREQUEST']._serialized_start=2184
-  _globals['_GETIMPORTSREQUEST']._serialized_end=2203
-  _globals['_IMPORT']._serialized_start=2205
-  _globals['_IMPORT']._serialized_end=2277
-  _globals['_GETIMPORTSRESPONSE']._serialized_start=2279
-  _globals['_GETIMPORTSRESPONSE']._serialized_end=2354
-  _globals['_GETEXPORTSREQUEST']._serialized_start=2356
-  _globals['_GETEXPORTSREQUEST']._serialized_end=2375
-  _globals['_EXPORT']._serialized_start=2377
-  _globals['_EXPORT']._serialized_end=2448
-  _globals['_GETEXPORTSRESPONSE']._serialized_start=2450
-  _globals['_GETEXPORTSRESPONSE']._serialized_end=2525
-  _globals['_GETENTRYPOINTREQUEST']._serialized_start=2527
-  _globals['_GETENTRYPOINTREQUEST']._serialized_end=2549
-  _globals['_GETENTRYPOINTRESPONSE']._serialized_start=2551
-  _globals['_GETENTRYPOINTRESPONSE']._serialized_end=2606
-  _globals['_GETSTRINGSREQUEST']._serialized_start=2608
-  _globals['_GETSTRINGSREQUEST']._serialized_end=2658
-  _globals['_STRINGITEM']._serialized_start=2660
-  _globals['_STRINGITEM']._serialized_end=2704
-  _globals['_GETSTRINGSRESPONSE']._serialized_start=2706
-  _globals['_GETSTRINGSRESPONSE']._serialized_end=2831
-  _globals['_MAKEFUNCTIONREQUEST']._serialized_start=2833
-  _globals['_MAKEFUNCTIONREQUEST']._serialized_end=2871
-  _globals['_MAKEFUNCTIONRESPONSE']._serialized_start=2873
-  _globals['_MAKEFUNCTIONRESPONSE']._serialized_end=2927
-  _globals['_IMPORTIL2CPPREQUEST']._serialized_start=2929
-  _globals['_IMPORTIL2CPPREQUEST']._serialized_end=3008
-  _globals['_IMPORTIL2CPPRESPONSE']._serialized_start=3011
-  _globals['_IMPORTIL2CPPRESPONSE']._serialized_end=3244
-  _globals['_IMPORTFLUTTERREQUEST']._serialized_start=3246
-  _globals['_IMPORTFLUTTERREQUEST']._serialized_end=3297
-  _globals['_IMPORTFLUTTERRESPONSE']._serialized_start=3300
-  _globals['_IMPORTFLUTTERRESPONSE']._serialized_end=3433
-  _globals['_GETDWORDATREQUEST']._serialized_start=3435
-  _globals['_GETDWORDATREQUEST']._serialized_end=3471
-  _globals['_GETDWORDATRESPONSE']._serialized_start=3473
-  _globals['_GETDWORDATRESPONSE']._serialized_end=3523
-  _globals['_GETQWORDATREQUEST']._serialized_start=3525
-  _globals['_GETQWORDATREQUEST']._serialized_end=3561
-  _globals['_GETQWORDATRESPONSE']._serialized_start=3563
-  _globals['_GETQWORDATRESPONSE']._serialized_end=3613
-  _globals['_GETINSTRUCTIONLENGTHREQUEST']._serialized_start=3615
-  _globals['_GETINSTRUCTIONLENGTHREQUEST']._serialized_end=3661
-  _globals['_GETINSTRUCTIONLENGTHRESPONSE']._serialized_start=3663
-  _globals['_GETINSTRUCTIONLENGTHRESPONSE']._serialized_end=3724
-  _globals['_PINGREQUEST']._serialized_start=3726
-  _globals['_PINGREQUEST']._serialized_end=3739
-  _globals['_PINGRESPONSE']._serialized_start=3741
-  _globals['_PINGRESPONSE']._serialized_end=3770
-  _globals['_STATUSSTREAMREQUEST']._serialized_start=3772
-  _globals['_STATUSSTREAMREQUEST']._serialized_end=3819
-  _globals['_WORKERSTATUS']._serialized_start=3821
-  _globals['_WORKERSTATUS']._serialized_end=3940
-  _globals['_SETCOMMENTREQUEST']._serialized_start=3942
-  _globals['_SETCOMMENTREQUEST']._serialized_end=4015
-  _globals['_SETCOMMENTRESPONSE']._serialized_start=4017
-  _globals['_SETCOMMENTRESPONSE']._serialized_end=4069
-  _globals['_GETCOMMENTREQUEST']._serialized_start=4071
-  _globals['_GETCOMMENTREQUEST']._serialized_end=4127
-  _globals['_GETCOMMENTRESPONSE']._serialized_start=4129
-  _globals['_GETCOMMENTRESPONSE']._serialized_end=4181
-  _globals['_SETFUNCCOMMENTREQUEST']._serialized_start=4183
-  _globals['_SETFUNCCOMMENTREQUEST']._serialized_end=4240
-  _globals['_SETFUNCCOMMENTRESPONSE']._serialized_start=4242
-  _globals['_SETFUNCCOMMENTRESPONSE']._serialized_end=4298
-  _globals['_SETLVARTYPEREQUEST']._serialized_start=4300
-  _globals['_SETLVARTYPEREQUEST']._serialized_end=4384
-  _globals['_SETLVARTYPERESPONSE']._serialized_start=4386
-  _globals['_SETLVARTYPERESPONSE']._serialized_end=4439
-  _globals['_RENAMELVARREQUEST']._serialized_start=4441
-  _globals['_RENAMELVARREQUEST']._serialized_end=4523
-  _globals['_RENAMELVARRESPONSE']._serialized_start=4525
-  _globals['_RENAMELVARRESPONSE']._serialized_end=4577
-  _globals['_SETDECOMPILERCOMMENTREQUEST']._serialized_start=4579
-  _globals['_SETDECOMPILERCOMMENTREQUEST']._serialized_end=4668
-  _globals['_SETDECOMPILERCOMMENTRESPONSE']._serialized_start=4670
-  _globals['_SETDECOMPILERCOMMENTRESPONSE']._serialized_end=4732
-  _globals['_GETGLOBALSREQUEST']._serialized_start=4734
-  _globals['_GETGLOBALSREQUEST']._serialized_end=4792
-  _globals['_GLOBALVARIABLE']._serialized_start=4794
-  _globals['_GLOBALVARIABLE']._serialized_end=4855
-  _globals['_GETGLOBALSRESPONSE']._serialized_start=4857
-  _globals['_GETGLOBALSRESPONSE']._serialized_end=4940
-  _globals['_SETGLOBALTYPEREQUEST']._serialized_start=4942
-  _globals['_SETGLOBALTYPEREQUEST']._serialized_end=4995
-  _globals['_SETGLOBALTYPERESPONSE']._serialized_start=4997
-  _globals['_SETGLOBALTYPERESPONSE']._serialized_end=5052
-  _globals['_RENAMEGLOBALREQUEST']._serialized_start=5054
-  _globals['_RENAMEGLOBALREQUEST']._serialized_end=5110
-  _globals['_RENAMEGLOBALRESPONSE']._serialized_start=5112
-  _globals['_RENAMEGLOBALRESPONSE']._serialized_end=5166
-  _globals['_DATAREADSTRINGREQUEST']._serialized_start=5168
-  _globals['_DATAREADSTRINGREQUEST']._serialized_end=5228
-  _globals['_DATAREADSTRINGRESPONSE']._serialized_start=5230
-  _globals['_DATAREADSTRINGRESPONSE']._serialized_end=5284
-  _globals['_DATAREADBYTEREQUEST']._serialized_start=5286
-  _globals['_DATAREADBYTEREQUEST']._serialized_end=5324
-  _globals['_DATAREADBYTERESPONSE']._serialized_start=5326
-  _globals['_DATAREADBYTERESPONSE']._serialized_end=5378
-  _globals['_LISTSTRUCTSREQUEST']._serialized_start=5380
-  _globals['_LISTSTRUCTSREQUEST']._serialized_end=5439
-  _globals['_STRUCTSUMMARY']._serialized_start=5441
-  _globals['_STRUCTSUMMARY']._serialized_end=5496
-  _globals['_LISTSTRUCTSRESPONSE']._serialized_start=5498
-  _globals['_LISTSTRUCTSRESPONSE']._serialized_end=5581
-  _globals['_GETSTRUCTREQUEST']._serialized_start=5583
-  _globals['_GETSTRUCTREQUEST']._serialized_end=5615
-  _globals['_STRUCTMEMBER']._serialized_start=5617
-  _globals['_STRUCTMEMBER']._serialized_end=5689
-  _globals['_GETSTRUCTRESPONSE']._serialized_start=5691
-  _globals['_GETSTRUCTRESPONSE']._serialized_end=5811
-  _globals['_LISTENUMSREQUEST']._serialized_start=5813
-  _globals['_LISTENUMSREQUEST']._serialized_end=5870
-  _globals['_ENUMSUMMARY']._serialized_start=5872
-  _globals['_ENUMSUMMARY']._serialized_end=5911
-  _globals['_LISTENUMSRESPONSE']._serialized_start=5913
-  _globals['_LISTENUMSRESPONSE']._serialized_end=5990
-  _globals['_GETENUMREQUEST']._serialized_start=5992
-  _globals['_GETENUMREQUEST']._serialized_end=6022
-  _globals['_ENUMMEMBER']._serialized_start=6024
-  _globals['_ENUMMEMBER']._serialized_end=6065
-  _globals['_GETENUMRESPONSE']._serialized_start=6067
-  _globals['_GETENUMRESPONSE']._serialized_end=6169
-  _globals['_GETFUNCTIONINFOREQUEST']._serialized_start=6171
-  _globals['_GETFUNCTIONINFOREQUEST']._serialized_end=6212
-  _globals['_FUNCTIONFLAGS']._serialized_start=6214
-  _globals['_FUNCTIONFLAGS']._serialized_end=6325
-  _globals['_GETFUNCTIONINFORESPONSE']._serialized_start=6328
-  _globals['_GETFUNCTIONINFORESPONSE']._serialized_end=6573
-  _globals['_GETTYPEATREQUEST']._serialized_start=6575
-  _globals['_GETTYPEATREQUEST']._serialized_end=6610
-  _globals['_GETTYPEATRESPONSE']._serialized_start=6613
-  _globals['_GETTYPEATRESPONSE']._serialized_end=6815
-  _globals['_FINDBINARYREQUEST']._serialized_start=6817
-  _globals['_FINDBINARYREQUEST']._serialized_end=6900
-  _globals['_FINDBINARYRESPONSE']._serialized_start=6902
-  _globals['_FINDBINARYRESPONSE']._serialized_end=6956
-  _globals['_FINDTEXTREQUEST']._serialized_start=6958
-  _globals['_FINDTEXTREQUEST']._serialized_end=7060
-  _globals['_FINDTEXTRESPONSE']._serialized_start=7062
-  _globals['_FINDTEXTRESPONSE']._serialized_end=7114
-  _globals['_GETFUNCCOMMENTREQUEST']._serialized_start=7116
-  _globals['_GETFUNCCOMMENTREQUEST']._serialized_end=7156
-  _globals['_GETFUNCCOMMENTRESPONSE']._serialized_start=7158
-  _globals['_GETFUNCCOMMENTRESPONSE']._serialized_end=7214
-  _globals['_SETNAMEREQUEST']._serialized_start=7216
-  _globals['_SETNAMEREQUEST']._serialized_end=7263
-  _globals['_SETNAMERESPONSE']._serialized_start=7265
-  _globals['_SETNAMERESPONSE']._serialized_end=7314
-  _globals['_GETNAMEREQUEST']._serialized_start=7316
-  _globals['_GETNAMEREQUEST']._serialized_end=7349
-  _globals['_GETNAMERESPONSE']._serialized_start=7351
-  _globals['_GETNAMERESPONSE']._serialized_end=7397
-  _globals['_DELETENAMEREQUEST']._serialized_start=7399
-  _globals['_DELETENAMEREQUEST']._serialized_end=7435
-  _globals['_DELETENAMERESPONSE']._serialized_start=7437
-  _globals['_DELETENAMERESPONSE']._serialized_end=7489
-  _globals['_SETFUNCTIONTYPEREQUEST']._serialized_start=7491
-  _globals['_SETFUNCTIONTYPEREQUEST']._serialized_end=7551
-  _globals['_SETFUNCTIONTYPERESPONSE']._serialized_start=7553
-  _globals['_SETFUNCTIONTYPERESPONSE']._serialized_end=7610
-  _globals['_SESSIONCONTROL']._serialized_start=7613
-  _globals['_SESSIONCONTROL']._serialized_end=8071
-  _globals['_ANALYSISTOOLS']._serialized_start=8074
-  _globals['_ANALYSISTOOLS']._serialized_end=12031
-  _globals['_HEALTHCHECK']._serialized_start=12034
-  _globals['_HEALTHCHECK']._serialized_end=12195
+  _globals['_OPENBINARYREQUEST']._serialized_end=131
+  _globals['_OPENBINARYRESPONSE']._serialized_start=134
+  _globals['_OPENBINARYRESPONSE']._serialized_end=267
+  _globals['_CLOSESESSIONREQUEST']._serialized_start=269
+  _globals['_CLOSESESSIONREQUEST']._serialized_end=304
+  _globals['_CLOSESESSIONRESPONSE']._serialized_start=306
+  _globals['_CLOSESESSIONRESPONSE']._serialized_end=360
+  _globals['_SAVEDATABASEREQUEST']._serialized_start=362
+  _globals['_SAVEDATABASEREQUEST']._serialized_end=383
+  _globals['_SAVEDATABASERESPONSE']._serialized_start=385
+  _globals['_SAVEDATABASERESPONSE']._serialized_end=473
+  _globals['_PLANANDWAITREQUEST']._serialized_start=475
+  _globals['_PLANANDWAITREQUEST']._serialized_end=495
+  _globals['_PLANANDWAITRESPONSE']._serialized_start=497
+  _globals['_PLANANDWAITRESPONSE']._serialized_end=576
+  _globals['_GETSESSIONINFOREQUEST']._serialized_start=578
+  _globals['_GETSESSIONINFOREQUEST']._serialized_end=601
+  _globals['_GETSESSIONINFORESPONSE']._serialized_start=604
+  _globals['_GETSESSIONINFORESPONSE']._serialized_end=757
+  _globals['_CANCELREQUEST']._serialized_start=759
+  _globals['_CANCELREQUEST']._serialized_end=811
+  _globals['_CANCELRESPONSE']._serialized_start=813
+  _globals['_CANCELRESPONSE']._serialized_end=861
+  _globals['_GETBYTESREQUEST']._serialized_start=863
+  _globals['_GETBYTESREQUEST']._serialized_end=911
+  _globals['_GETBYTESRESPONSE']._serialized_start=913
+  _globals['_GETBYTESRESPONSE']._serialized_end=960
+  _globals['_GETDISASMREQUEST']._serialized_start=962
+  _globals['_GETDISASMREQUEST']._serialized_end=997
+  _globals['_GETDISASMRESPONSE']._serialized_start=999
+  _globals['_GETDISASMRESPONSE']._serialized_end=1049
+  _globals['_GETFUNCTIONDISASMREQUEST']._serialized_start=1051
+  _globals['_GETFUNCTIONDISASMREQUEST']._serialized_end=1094
+  _globals['_GETFUNCTIONDISASMRESPONSE']._serialized_start=1096
+  _globals['_GETFUNCTIONDISASMRESPONSE']._serialized_end=1159
+  _globals['_GETDECOMPILEDREQUEST']._serialized_start=1161
+  _globals['_GETDECOMPILEDREQUEST']._serialized_end=1200
+  _globals['_GETDECOMPILEDRESPONSE']._serialized_start=1202
+  _globals['_GETDECOMPILEDRESPONSE']._serialized_end=1254
+  _globals['_GETFUNCTIONNAMEREQUEST']._serialized_start=1256
+  _globals['_GETFUNCTIONNAMEREQUEST']._serialized_end=1297
+  _globals['_GETFUNCTIONNAMERESPONSE']._serialized_start=1299
+  _globals['_GETFUNCTIONNAMERESPONSE']._serialized_end=1353
+  _globals['_GETSEGMENTSREQUEST']._serialized_start=1355
+  _globals['_GETSEGMENTSREQUEST']._serialized_end=1375
+  _globals['_SEGMENT']._serialized_start=1377
+  _globals['_SEGMENT']._serialized_end=1485
+  _globals['_GETSEGMENTSRESPONSE']._serialized_start=1487
+  _globals['_GETSEGMENTSRESPONSE']._serialized_end=1565
+  _globals['_GETFUNCTIONSREQUEST']._serialized_start=1567
+  _globals['_GETFUNCTIONSREQUEST']._serialized_end=1588
+  _globals['_FUNCTION']._serialized_start=1590
+  _globals['_FUNCTION']._serialized_end=1631
+  _globals['_GETFUNCTIONSRESPONSE']._serialized_start=1633
+  _globals['_GETFUNCTIONSRESPONSE']._serialized_end=1714
+  _globals['_GETXREFSTOREQUEST']._serialized_start=1716
+  _globals['_GETXREFSTOREQUEST']._serialized_end=1752
+  _globals['_XREF']._serialized_start=1754
+  _globals['_XREF']._serialized_end=1800
+  _globals['_GETXREFSTORESPONSE']._serialized_start=1802
+  _globals['_GETXREFSTORESPONSE']._serialized_end=1873
+  _globals['_GETXREFSFROMREQUEST']._serialized_start=1875
+  _globals['_GETXREFSFROMREQUEST']._serialized_end=1913
+  _globals['_GETXREFSFROMRESPONSE']._serialized_start=1915
+  _globals['_GETXREFSFROMRESPONSE']._serialized_end=1988
+  _globals['_GETDATAREFSREQUEST']._serialized_start=1990
+  _globals['_GETDATAREFSREQUEST']._serialized_end=2027
+  _globals['_DATAREF']._serialized_start=2029
+  _globals['_DATAREF']._serialized_end=2066
+  _globals['_GETDATAREFSRESPONSE']._serialized_start=2068
+  _globals['_GETDATAREFSRESPONSE']._serialized_end=2142
+  _globals['_GETSTRINGXREFSREQUEST']._serialized_start=2144
+  _globals['_GETSTRINGXREFSREQUEST']._serialized_end=2184
+  _globals['_STRINGXREF']._serialized_start=2186
+  _globals['_STRINGXREF']._serialized_end=2264
+  _globals['_GETSTRINGXREFSRESPONSE']._serialized_start=2266
+  _globals['_GETSTRINGXREFSRESPONSE']._serialized_end=2346
+  _globals['_GETIMPORTSREQUEST']._serialized_start=2348
+  _globals['_GETIMPORTSREQUEST']._serialized_end=2367
+  _globals['_IMPORT']._serialized_start=2369
+  _globals['_IMPORT']._serialized_end=2441
+  _globals['_GETIMPORTSRESPONSE']._serialized_start=2443
+  _globals['_GETIMPORTSRESPONSE']._serialized_end=2518
+  _globals['_GETEXPORTSREQUEST']._serialized_start=2520
+  _globals['_GETEXPORTSREQUEST']._serialized_end=2539
+  _globals['_EXPORT']._serialized_start=2541
+  _globals['_EXPORT']._serialized_end=2612
+  _globals['_GETEXPORTSRESPONSE']._serialized_start=2614
+  _globals['_GETEXPORTSRESPONSE']._serialized_end=2689
+  _globals['_GETENTRYPOINTREQUEST']._serialized_start=2691
+  _globals['_GETENTRYPOINTREQUEST']._serialized_end=2713
+  _globals['_GETENTRYPOINTRESPONSE']._serialized_start=2715
+  _globals['_GETENTRYPOINTRESPONSE']._serialized_end=2770
+  _globals['_GETSTRINGSREQUEST']._serialized_start=2772
+  _globals['_GETSTRINGSREQUEST']._serialized_end=2822
+  _globals['_STRINGITEM']._serialized_start=2824
+  _globals['_STRINGITEM']._serialized_end=2868
+  _globals['_GETSTRINGSRESPONSE']._serialized_start=2870
+  _globals['_GETSTRINGSRESPONSE']._serialized_end=2995
+  _globals['_MAKEFUNCTIONREQUEST']._serialized_start=2997
+  _globals['_MAKEFUNCTIONREQUEST']._serialized_end=3035
+  _globals['_MAKEFUNCTIONRESPONSE']._serialized_start=3037
+  _globals['_MAKEFUNCTIONRESPONSE']._serialized_end=3091
+  _globals['_IMPORTIL2CPPREQUEST']._serialized_start=3093
+  _globals['_IMPORTIL2CPPREQUEST']._serialized_end=3172
+  _globals['_IMPORTIL2CPPRESPONSE']._serialized_start=3175
+  _globals['_IMPORTIL2CPPRESPONSE']._serialized_end=3408
+  _globals['_IMPORTFLUTTERREQUEST']._serialized_start=3410
+  _globals['_IMPORTFLUTTERREQUEST']._serialized_end=3461
+  _globals['_IMPORTFLUTTERRESPONSE']._serialized_start=3464
+  _globals['_IMPORTFLUTTERRESPONSE']._serialized_end=3597
+  _globals['_GETDWORDATREQUEST']._serialized_start=3599
+  _globals['_GETDWORDATREQUEST']._serialized_end=3635
+  _globals['_GETDWORDATRESPONSE']._serialized_start=3637
+  _globals['_GETDWORDATRESPONSE']._serialized_end=3687
+  _globals['_GETQWORDATREQUEST']._serialized_start=3689
+  _globals['_GETQWORDATREQUEST']._serialized_end=3725
+  _globals['_GETQWORDATRESPONSE']._serialized_start=3727
+  _globals['_GETQWORDATRESPONSE']._serialized_end=3777
+  _globals['_GETINSTRUCTIONLENGTHREQUEST']._serialized_start=3779
+  _globals['_GETINSTRUCTIONLENGTHREQUEST']._serialized_end=3825
+  _globals['_GETINSTRUCTIONLENGTHRESPONSE']._serialized_start=3827
+  _globals['_GETINSTRUCTIONLENGTHRESPONSE']._serialized_end=3888
+  _globals['_PINGREQUEST']._serialized_start=3890
+  _globals['_PINGREQUEST']._serialized_end=3903
+  _globals['_PINGRESPONSE']._serialized_start=3905
+  _globals['_PINGRESPONSE']._serialized_end=3934
+  _globals['_STATUSSTREAMREQUEST']._serialized_start=3936
+  _globals['_STATUSSTREAMREQUEST']._serialized_end=3983
+  _globals['_WORKERSTATUS']._serialized_start=3985
+  _globals['_WORKERSTATUS']._serialized_end=4104
+  _globals['_SETCOMMENTREQUEST']._serialized_start=4106
+  _globals['_SETCOMMENTREQUEST']._serialized_end=4179
+  _globals['_SETCOMMENTRESPONSE']._serialized_start=4181
+  _globals['_SETCOMMENTRESPONSE']._serialized_end=4233
+  _globals['_GETCOMMENTREQUEST']._serialized_start=4235
+  _globals['_GETCOMMENTREQUEST']._serialized_end=4291
+  _globals['_GETCOMMENTRESPONSE']._serialized_start=4293
+  _globals['_GETCOMMENTRESPONSE']._serialized_end=4345
+  _globals['_SETFUNCCOMMENTREQUEST']._serialized_start=4347
+  _globals['_SETFUNCCOMMENTREQUEST']._serialized_end=4404
+  _globals['_SETFUNCCOMMENTRESPONSE']._serialized_start=4406
+  _globals['_SETFUNCCOMMENTRESPONSE']._serialized_end=4462
+  _globals['_SETLVARTYPEREQUEST']._serialized_start=4464
+  _globals['_SETLVARTYPEREQUEST']._serialized_end=4548
+  _globals['_SETLVARTYPERESPONSE']._serialized_start=4550
+  _globals['_SETLVARTYPERESPONSE']._serialized_end=4603
+  _globals['_RENAMELVARREQUEST']._serialized_start=4605
+  _globals['_RENAMELVARREQUEST']._serialized_end=4687
+  _globals['_RENAMELVARRESPONSE']._serialized_start=4689
+  _globals['_RENAMELVARRESPONSE']._serialized_end=4741
+  _globals['_SETDECOMPILERCOMMENTREQUEST']._serialized_start=4743
+  _globals['_SETDECOMPILERCOMMENTREQUEST']._serialized_end=4832
+  _globals['_SETDECOMPILERCOMMENTRESPONSE']._serialized_start=4834
+  _globals['_SETDECOMPILERCOMMENTRESPONSE']._serialized_end=4896
+  _globals['_GETGLOBALSREQUEST']._serialized_start=4898
+  _globals['_GETGLOBALSREQUEST']._serialized_end=4956
+  _globals['_GLOBALVARIABLE']._serialized_start=4958
+  _globals['_GLOBALVARIABLE']._serialized_end=5019
+  _globals['_GETGLOBALSRESPONSE']._serialized_start=5021
+  _globals['_GETGLOBALSRESPONSE']._serialized_end=5104
+  _globals['_SETGLOBALTYPEREQUEST']._serialized_start=5106
+  _globals['_SETGLOBALTYPEREQUEST']._serialized_end=5159
+  _globals['_SETGLOBALTYPERESPONSE']._serialized_start=5161
+  _globals['_SETGLOBALTYPERESPONSE']._serialized_end=5216
+  _globals['_RENAMEGLOBALREQUEST']._serialized_start=5218
+  _globals['_RENAMEGLOBALREQUEST']._serialized_end=5274
+  _globals['_RENAMEGLOBALRESPONSE']._serialized_start=5276
+  _globals['_RENAMEGLOBALRESPONSE']._serialized_end=5330
+  _globals['_DATAREADSTRINGREQUEST']._serialized_start=5332
+  _globals['_DATAREADSTRINGREQUEST']._serialized_end=5392
+  _globals['_DATAREADSTRINGRESPONSE']._serialized_start=5394
+  _globals['_DATAREADSTRINGRESPONSE']._serialized_end=5448
+  _globals['_DATAREADBYTEREQUEST']._serialized_start=5450
+  _globals['_DATAREADBYTEREQUEST']._serialized_end=5488
+  _globals['_DATAREADBYTERESPONSE']._serialized_start=5490
+  _globals['_DATAREADBYTERESPONSE']._serialized_end=5542
+  _globals['_LISTSTRUCTSREQUEST']._serialized_start=5544
+  _globals['_LISTSTRUCTSREQUEST']._serialized_end=5603
+  _globals['_STRUCTSUMMARY']._serialized_start=5605
+  _globals['_STRUCTSUMMARY']._serialized_end=5660
+  _globals['_LISTSTRUCTSRESPONSE']._serialized_start=5662
+  _globals['_LISTSTRUCTSRESPONSE']._serialized_end=5745
+  _globals['_GETSTRUCTREQUEST']._serialized_start=5747
+  _globals['_GETSTRUCTREQUEST']._serialized_end=5779
+  _globals['_STRUCTMEMBER']._serialized_start=5781
+  _globals['_STRUCTMEMBER']._serialized_end=5853
+  _globals['_GETSTRUCTRESPONSE']._serialized_start=5855
+  _globals['_GETSTRUCTRESPONSE']._serialized_end=5975
+  _globals['_LISTENUMSREQUEST']._serialized_start=5977
+  _globals['_LISTENUMSREQUEST']._serialized_end=6034
+  _globals['_ENUMSUMMARY']._serialized_start=6036
+  _globals['_ENUMSUMMARY']._serialized_end=6075
+  _globals['_LISTENUMSRESPONSE']._serialized_start=6077
+  _globals['_LISTENUMSRESPONSE']._serialized_end=6154
+  _globals['_GETENUMREQUEST']._serialized_start=6156
+  _globals['_GETENUMREQUEST']._serialized_end=6186
+  _globals['_ENUMMEMBER']._serialized_start=6188
+  _globals['_ENUMMEMBER']._serialized_end=6229
+  _globals['_GETENUMRESPONSE']._serialized_start=6231
+  _globals['_GETENUMRESPONSE']._serialized_end=6333
+  _globals['_GETFUNCTIONINFOREQUEST']._serialized_start=6335
+  _globals['_GETFUNCTIONINFOREQUEST']._serialized_end=6376
+  _globals['_FUNCTIONFLAGS']._serialized_start=6378
+  _globals['_FUNCTIONFLAGS']._serialized_end=6489
+  _globals['_GETFUNCTIONINFORESPONSE']._serialized_start=6492
+  _globals['_GETFUNCTIONINFORESPONSE']._serialized_end=6737
+  _globals['_GETTYPEATREQUEST']._serialized_start=6739
+  _globals['_GETTYPEATREQUEST']._serialized_end=6774
+  _globals['_GETTYPEATRESPONSE']._serialized_start=6777
+  _globals['_GETTYPEATRESPONSE']._serialized_end=6979
+  _globals['_FINDBINARYREQUEST']._serialized_start=6981
+  _globals['_FINDBINARYREQUEST']._serialized_end=7064
+  _globals['_FINDBINARYRESPONSE']._serialized_start=7066
+  _globals['_FINDBINARYRESPONSE']._serialized_end=7120
+  _globals['_FINDTEXTREQUEST']._serialized_start=7122
+  _globals['_FINDTEXTREQUEST']._serialized_end=7224
+  _globals['_FINDTEXTRESPONSE']._serialized_start=7226
+  _globals['_FINDTEXTRESPONSE']._serialized_end=7278
+  _globals['_GETFUNCCOMMENTREQUEST']._serialized_start=7280
+  _globals['_GETFUNCCOMMENTREQUEST']._serialized_end=7320
+  _globals['_GETFUNCCOMMENTRESPONSE']._serialized_start=7322
+  _globals['_GETFUNCCOMMENTRESPONSE']._serialized_end=7378
+  _globals['_SETNAMEREQUEST']._serialized_start=7380
+  _globals['_SETNAMEREQUEST']._serialized_end=7427
+  _globals['_SETNAMERESPONSE']._serialized_start=7429
+  _globals['_SETNAMERESPONSE']._serialized_end=7478
+  _globals['_GETNAMEREQUEST']._serialized_start=7480
+  _globals['_GETNAMEREQUEST']._serialized_end=7513
+  _globals['_GETNAMERESPONSE']._serialized_start=7515
+  _globals['_GETNAMERESPONSE']._serialized_end=7561
+  _globals['_DELETENAMEREQUEST']._serialized_start=7563
+  _globals['_DELETENAMEREQUEST']._serialized_end=7599
+  _globals['_DELETENAMERESPONSE']._serialized_start=7601
+  _globals['_DELETENAMERESPONSE']._serialized_end=7653
+  _globals['_SETFUNCTIONTYPEREQUEST']._serialized_start=7655
+  _globals['_SETFUNCTIONTYPEREQUEST']._serialized_end=7715
+  _globals['_SETFUNCTIONTYPERESPONSE']._serialized_start=7717
+  _globals['_SETFUNCTIONTYPERESPONSE']._serialized_end=7774
+  _globals['_SESSIONCONTROL']._serialized_start=7777
+  _globals['_SESSIONCONTROL']._serialized_end=8306
+  _globals['_ANALYSISTOOLS']._serialized_start=8309
+  _globals['_ANALYSISTOOLS']._serialized_end=12266
+  _globals['_HEALTHCHECK']._serialized_start=12269
+  _globals['_HEALTHCHECK']._serialized_end=12430
 # @@protoc_insertion_point(module_scope)
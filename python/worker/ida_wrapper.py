@@ -9,6 +9,7 @@ import os
 import re
 import shutil
 import tempfile
+import threading
 import time
 from pathlib import Path
 
@@ -20,14 +21,21 @@ from errors import IDAError
 class IDAWrapper:
     """Wrapper around idalib with error handling"""
 
-    def __init__(self, binary_path: str, session_id: str):
+    def __init__(self, binary_path: str, session_id: str, database_path: str | None = None):
         self.binary_path = binary_path
         self.session_id = session_id
+        # Where the server wants the database; None keeps it next to the binary
+        self.database_path = database_path
         self.db_open = False
         self.has_decompiler = False
+        self.processor = ""
+        self.bitness = 0
         self.opened_at = None
         self.last_activity = None
         self.last_error: str | None = None
+        self.dirty = False
+        # Set by SessionControl.Cancel; long loops poll it via check_cancelled
+        self.cancel_event = threading.Event()
 
         # IDA modules (available after database opens)
         self.ida_auto = None
@@ -43,14 +51,34 @@ class IDAWrapper:
         self.ida_nalt = None
         self.analysis_state = "not_started"
 
+    def _open(self, auto_analyze: bool) -> int:
+        """Open the database, keeping it at database_path when one is set.
+
+        The input binary is only read: an existing database is opened
+        directly, and a new one is written to database_path with -o.
+        """
+        if not self.database_path:
+            return idapro.open_database(self.binary_path, auto_analyze)
+        if os.path.exists(self.database_path):
+            return idapro.open_database(self.database_path, auto_analyze)
+        os.makedirs(os.path.dirname(self.database_path), exist_ok=True)
+        return idapro.open_database(self.binary_path, auto_analyze, f'-o"{self.database_path}"')
+
     def open_database(self, auto_analyze: bool = False) -> bool:
         """Open IDA database"""
         self.last_error = None
         try:
             import os
 
-            # Check if binary file exists
-            if not os.path.exists(self.binary_path):
+            if not self.binary_path:
+                msg = "No binary assigned to this worker"
+                logging.error(msg)
+                self.last_error = msg
+                return False
+
+            # Check if binary file exists; a placed database opens without it
+            have_database = bool(self.database_path) and os.path.exists(self.database_path)
+            if not have_database and not os.path.exists(self.binary_path):
                 msg = f"Binary file not found: {self.binary_path}"
                 logging.error(msg)
                 self.last_error = msg
@@ -63,7 +91,7 @@ class IDAWrapper:
                 logging.warning("idapro.enable_console_messages not available in this IDA version")
 
             # Open database with compression
-            result = idapro.open_database(self.binary_path, auto_analyze)
+            result = self._open(auto_analyze)
 
             if result != 0:
                 error_msgs = {
@@ -83,7 +111,7 @@ class IDAWrapper:
                     import glob
 
                     # Find and delete all IDA database files for this binary
-                    base_path = os.path.splitext(self.binary_path)[0]
+                    base_path = os.path.splitext(self.database_path or self.binary_path)[0]
                     patterns = [
                         f"{base_path}.i64",
                         f"{base_path}.idb",
@@ -110,7 +138,7 @@ class IDAWrapper:
                     else:
                         logging.info("No existing database files found, creating fresh database...")
 
-                    result = idapro.open_database(self.binary_path, True)
+                    result = self._open(True)
 
                     if result != 0:
                         final_error = error_msgs.get(result, f'Error code {result}')
@@ -156,11 +184,13 @@ class IDAWrapper:
                 logging.debug("Hex-Rays decompiler not available: %s", e)
                 self.has_decompiler = False
 
+            self.processor, self.bitness = self._read_processor()
+
             self.db_open = True
             self.opened_at = time.time()
             self.last_activity = time.time()
 
-            logging.info(f"Database opened (decompiler: {self.has_decompiler})")
+            logging.info(f"Database opened (processor: {self.processor}, {self.bitness}-bit, decompiler: {self.has_decompiler})")
             self.last_error = None
             self.analysis_state = "idle"
             return True
@@ -171,6 +201,21 @@ class IDAWrapper:
             self.last_error = message
             return False
 
+    def _read_processor(self) -> tuple[str, int]:
+        """Return the database's processor module name and bitness"""
+        try:
+            import ida_ida
+            if ida_ida.inf_is_64bit():
+                bitness = 64
+            elif ida_ida.inf_is_32bit_exactly():
+                bitness = 32
+            else:
+                bitness = 16
+            return ida_ida.inf_get_procname(), bitness
+        except Exception as e:
+            logging.debug("Could not read processor info: %s", e)
+            return "", 0
+
     def save_database(self) -> tuple[bool, int, bool]:
         """
         Save IDA database
@@ -180,19 +225,15 @@ class IDAWrapper:
             if not self.db_open:
                 return (False, 0, False)
 
-            # Check if database has unsaved changes (API may vary across versions)
-            dirty = False
-            if hasattr(self.idaapi, 'DBFL_KILL') and hasattr(self.idaapi, 'is_database_flag'):
-                try:
-                    dirty = self.idaapi.is_database_flag(self.idaapi.DBFL_KILL)
-                except Exception as e:
-                    logging.debug("Failed to check database dirty flag: %s", e)
+            # Unsaved changes are tracked by mark_dirty() on every mutating RPC
+            dirty = self.dirty
 
             # Save database via idaapi (idapro.save_database unavailable in some builds)
             result = self.idaapi.save_database("", 0)
 
             if result:
                 timestamp = int(time.time())
+                self.dirty = False
                 logging.info(f"Database saved (dirty: {dirty})")
                 return (True, timestamp, dirty)
             else:
@@ -220,10 +261,20 @@ class IDAWrapper:
             logging.error(f"Error closing database: {e}")
             return False
 
+    def mark_dirty(self):
+        """Record that the database has changes not yet written to disk"""
+        self.dirty = True
+
     def touch(self):
         """Update last activity timestamp"""
         self.last_activity = time.time()
 
+    def check_cancelled(self, operation: str):
+        """Safe point of a long operation: stop here if the call was cancelled"""
+        if self.cancel_event.is_set():
+            logging.info(f"{operation} cancelled")
+            raise IDAError.cancelled(operation=operation)
+
     def get_auto_status(self) -> tuple[bool, str]:
         """Return (is_auto_running, state_name) from ida_auto if available"""
         if not self.db_open or self.ida_auto is None:
@@ -281,11 +332,18 @@ class IDAWrapper:
             import time
 
             start = time.time()
+            # Segment by segment, so a cancelled call stops between them
+            for seg_start in self.idautils.Segments():
+                self.check_cancelled("plan_and_wait")
+                self.ida_auto.auto_wait_range(seg_start, self.idc.get_segm_end(seg_start))
+            self.check_cancelled("plan_and_wait")
             self.ida_auto.auto_wait()
             duration = time.time() - start
             self.last_activity = time.time()
             self.analysis_state = "idle"
             return (True, duration, None)
+        except IDAError:
+            raise
         except Exception as exc:
             return (False, 0.0, str(exc))
 
@@ -352,6 +410,7 @@ class IDAWrapper:
         if "Addresses" in enabled and isinstance(metadata.get("Addresses"), list):
             addresses = metadata["Addresses"]
             for idx in range(len(addresses) - 1):
+                self.check_cancelled("import_il2cpp")
                 start_ea = abs_addr(addresses[idx])
                 end_ea = abs_addr(addresses[idx + 1])
                 if start_ea is None or end_ea is None or start_ea >= end_ea:
@@ -370,6 +429,7 @@ class IDAWrapper:
 
         if "ScriptMethod" in enabled and isinstance(metadata.get("ScriptMethod"), list):
             for item in metadata["ScriptMethod"]:
+                self.check_cancelled("import_il2cpp")
                 addr = abs_addr(item.get("Address"))
                 name = item.get("Name")
                 if addr is None or not name:
@@ -387,6 +447,7 @@ class IDAWrapper:
 
         if "ScriptString" in enabled and isinstance(metadata.get("ScriptString"), list):
             for idx, item in enumerate(metadata["ScriptString"], start=1):
+                self.check_cancelled("import_il2cpp")
                 addr = abs_addr(item.get("Address"))
                 if addr is None:
                     continue
@@ -397,6 +458,7 @@ class IDAWrapper:
 
         if "ScriptMetadata" in enabled and isinstance(metadata.get("ScriptMetadata"), list):
             for item in metadata["ScriptMetadata"]:
+                self.check_cancelled("import_il2cpp")
                 addr = abs_addr(item.get("Address"))
                 name = item.get("Name")
                 if addr is None or not name:
@@ -407,6 +469,7 @@ class IDAWrapper:
 
         if "ScriptMetadataMethod" in enabled and isinstance(metadata.get("ScriptMetadataMethod"), list):
             for item in metadata["ScriptMetadataMethod"]:
+                self.check_cancelled("import_il2cpp")
                 addr = abs_addr(item.get("Address"))
                 method_addr = abs_addr(item.get("MethodAddress"))
                 name = item.get("Name")
@@ -457,6 +520,7 @@ class IDAWrapper:
         # Pattern: ida_funcs.add_func(0x133830, 0x133830)
         add_func_pattern = re.compile(r"ida_funcs\.add_func\(0x([0-9a-fA-F]+),\s*0x([0-9a-fA-F]+)\)")
         for match in add_func_pattern.finditer(script_content):
+            self.check_cancelled("import_flutter")
             start_addr = int(match.group(1), 16)
             end_addr = int(match.group(2), 16)
 
@@ -479,6 +543,7 @@ class IDAWrapper:
         # Pattern: idaapi.set_name(0x133830, "dart_core_::get__uriBaseClosure_133830")
         set_name_pattern = re.compile(r"idaapi\.set_name\(0x([0-9a-fA-F]+),\s*\"([^\"]+)\"\)")
         for match in set_name_pattern.finditer(script_content):
+            self.check_cancelled("import_flutter")
             addr = int(match.group(1), 16)
             name = match.group(2)
             try:
@@ -1004,6 +1069,7 @@ class IDAWrapper:
         direction = ida_bytes.BIN_SEARCH_BACKWARD if search_up else ida_bytes.BIN_SEARCH_FORWARD
 
         while True:
+            self.check_cancelled("find_binary")
             # bin_search returns (ea, size) tuple
             result = ida_bytes.bin_search(ea, end, compiled_pattern, direction)
             next_ea = result[0] if isinstance(result, tuple) else result
@@ -1036,6 +1102,7 @@ class IDAWrapper:
             flags |= ida_search.SEARCH_CASE
 
         while True:
+            self.check_cancelled("find_text")
             # Use find_text with proper signature: find_text(ea, y, x, needle, flags)
             next_ea = ida_search.find_text(ea, 0, 0, search_text, flags)
             if next_ea == self.idaapi.BADADDR or next_ea in visited:
@@ -10,8 +10,10 @@ IPC transport is chosen automatically based on the arguments:
 import argparse
 import logging
 import os
+import queue
 import socket
 import sys
+import threading
 import types
 from pathlib import Path
 
@@ -41,6 +43,49 @@ except ImportError:
 from connect_server import ConnectServer
 from ida_wrapper import IDAWrapper
 
+# Exit status after an allocation fails under the memory limit (ENOMEM), so
+# the server reports the limit instead of restarting the worker.
+EXIT_OUT_OF_MEMORY = 12
+
+
+class MainThreadExecutor:
+    """Runs callables on the main thread, where idalib expects to be called."""
+
+    def __init__(self):
+        self._calls = queue.Queue()
+
+    def run(self, fn):
+        """Run *fn* on the main thread and return its result or raise its error."""
+        done = threading.Event()
+        outcome = {}
+
+        def call():
+            try:
+                outcome["result"] = fn()
+            except MemoryError:
+                # idalib may be left half-updated; exit rather than serve on.
+                logging.critical("Out of memory, exiting")
+                os._exit(EXIT_OUT_OF_MEMORY)
+            except BaseException as e:
+                outcome["error"] = e
+            finally:
+                done.set()
+
+        self._calls.put(call)
+        done.wait()
+        if "error" in outcome:
+            raise outcome["error"]
+        return outcome["result"]
+
+    def serve_forever(self, accept_thread: threading.Thread):
+        """Execute queued calls until *accept_thread* stops."""
+        while accept_thread.is_alive():
+            try:
+                call = self._calls.get(timeout=0.5)
+            except queue.Empty:
+                continue
+            call()
+
 
 def serve(server_socket: socket.socket, handler, session_id: str, label: str):
     """Accept connections on *server_socket* and dispatch each one to *handler*."""
@@ -48,7 +93,9 @@ def serve(server_socket: socket.socket, handler, session_id: str, label: str):
     try:
         while True:
             conn, _ = server_socket.accept()
-            handle_connection(conn, handler)
+            # One thread per connection so health checks and the status stream
+            # are served while an analysis call is running.
+            threading.Thread(target=handle_connection, args=(conn, handler), daemon=True).start()
     finally:
         server_socket.close()
 
@@ -72,6 +119,44 @@ def make_tcp_socket(port: int) -> socket.socket:
     return sock
 
 
+def read_chunked(conn: socket.socket, received: bytes) -> bytes:
+    """Read an HTTP/1.1 chunked body from *conn* and return it decoded.
+
+    *received* is the part of the body that arrived with the headers. The body
+    ends at the zero-size chunk and its trailers, wherever they fall within
+    the reads.
+    """
+    buf = bytearray(received)
+    pos = 0
+
+    def fill():
+        chunk = conn.recv(65536)
+        if not chunk:
+            raise ConnectionError("connection closed inside a chunked body")
+        buf.extend(chunk)
+
+    def read_line() -> bytes:
+        nonlocal pos
+        while (end := buf.find(b"\r\n", pos)) < 0:
+            fill()
+        line = bytes(buf[pos:end])
+        pos = end + 2
+        return line
+
+    out = bytearray()
+    while True:
+        size = int(read_line().split(b";")[0], 16)
+        if size == 0:
+            # Trailer fields, if any, up to the empty line
+            while read_line():
+                pass
+            return bytes(out)
+        while len(buf) < pos + size + 2:
+            fill()
+        out += buf[pos:pos + size]
+        pos += size + 2
+
+
 def handle_connection(conn: socket.socket, handler):
     """Handle a single HTTP/1.1 request arriving on *conn*."""
     try:
@@ -89,19 +174,35 @@ def handle_connection(conn: socket.socket, handler):
                             content_length = int(line.split(b":")[1].strip())
                             body_start = request_data.find(b"\r\n\r\n") + 4
                             body_received = len(request_data) - body_start
-                            if body_received < content_length:
-                                remaining = content_length - body_received
-                                request_data += conn.recv(remaining)
+                            while body_received < content_length:
+                                chunk = conn.recv(content_length - body_received)
+                                if not chunk:
+                                    break
+                                request_data += chunk
+                                body_received += len(chunk)
                 break
 
         if not request_data:
             return
 
+        # Streaming calls send a chunked body (no Content-Length)
+        head, sep, body = request_data.partition(b"\r\n\r\n")
+        if b"transfer-encoding: chunked" in head.lower():
+            request_data = head + sep + read_chunked(conn, body)
+
         lines = request_data.split(b"\r\n")
         request_line = lines[0].decode("utf-8")
         method, path, _ = request_line.split()
         response = handler(method, path, request_data)
-        conn.sendall(response.encode() if isinstance(response, str) else response)
+        if isinstance(response, (bytes, str)):
+            conn.sendall(response.encode() if isinstance(response, str) else response)
+        else:
+            # Server stream: send chunks until the client goes away
+            try:
+                for chunk in response:
+                    conn.sendall(chunk)
+            except (BrokenPipeError, ConnectionResetError):
+                pass
 
     except Exception as e:
         logging.error(f"Connection error: {e}")
@@ -116,23 +217,32 @@ def main():
     transport.add_argument("--socket", help="Unix domain socket path (Linux/macOS)")
     transport.add_argument("--port", type=int, help="TCP loopback port (Windows)")
 
-    parser.add_argument("--binary", required=True, help="Binary file path")
+    parser.add_argument("--binary", help="Binary file path")
+    parser.add_argument("--database", help="IDA database path (default: next to the binary)")
+    parser.add_argument("--pool", action="store_true",
+                        help="Start idle with idalib loaded; the binary arrives with OpenBinary")
     parser.add_argument("--session-id", required=True, help="Session ID")
     parser.add_argument("--log-level", default="INFO", help="Log level")
     args = parser.parse_args()
+    if not args.binary and not args.pool:
+        parser.error("--binary is required unless --pool is given")
 
     logging.basicConfig(
         level=getattr(logging, args.log_level),
         format=f"[Worker {args.session_id}] %(asctime)s - %(levelname)s - %(message)s",
     )
 
-    logging.info(f"Starting worker for binary: {args.binary}")
+    if args.pool:
+        logging.info("Starting idle pool worker")
+    else:
+        logging.info(f"Starting worker for binary: {args.binary}")
     logging.info("Initializing Connect server (IDA database will open on demand)")
 
-    ida = IDAWrapper(args.binary, args.session_id)
-    server = ConnectServer(ida)
+    ida = IDAWrapper(args.binary, args.session_id, args.database)
+    executor = MainThreadExecutor()
+    server = ConnectServer(ida, run_ida=executor.run)
 
-    def handle_request(method: str, path: str, data: bytes) -> bytes:
+    def handle_request(method: str, path: str, data: bytes):
         return server.handle(method, path, data)
 
     try:
@@ -143,7 +253,11 @@ def main():
             sock = make_tcp_socket(args.port)
             label = f"127.0.0.1:{args.port}"
 
-        serve(sock, handle_request, args.session_id, label)
+        accept_thread = threading.Thread(
+            target=serve, args=(sock, handle_request, args.session_id, label), daemon=True
+        )
+        accept_thread.start()
+        executor.serve_forever(accept_thread)
 
         # Cleanup Unix socket file on exit
         if args.socket and os.path.exists(args.socket):